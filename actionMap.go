@@ -0,0 +1,445 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"sort"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+const (
+	BindingKey            BindingKind = iota // A keyboard key
+	BindingMouseButton                       // A mouse button
+	BindingJoystickButton                    // A joystick button
+	BindingJoystickAxis                      // A joystick axis, value in [-1 .. 1]
+	BindingKeyAxis                           // Two keys forming an axis, Negative gives -1 and Positive gives 1
+)
+
+type BindingKind int
+
+// Version of the format written by ActionMap.Save
+const actionMapVersion = 1
+
+// Position a joystick axis must reach to be picked up by ActionMap.Listen, in range [0 .. 100]
+const listenAxisThreshold = 50
+
+var bindingKindNames = [...]string{
+	BindingKey:            "key",
+	BindingMouseButton:    "mouseButton",
+	BindingJoystickButton: "joystickButton",
+	BindingJoystickAxis:   "joystickAxis",
+	BindingKeyAxis:        "keyAxis",
+}
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Binding maps a physical input to an action
+//
+// Only the fields relevant to Kind are used.
+type Binding struct {
+	Kind     BindingKind  `json:"kind"`
+	Key      KeyCode      `json:"key,omitempty"`      // BindingKey
+	Negative KeyCode      `json:"negative,omitempty"` // BindingKeyAxis
+	Positive KeyCode      `json:"positive,omitempty"` // BindingKeyAxis
+	Button   MouseButton  `json:"button,omitempty"`   // BindingMouseButton
+	Joystick uint         `json:"joystick,omitempty"` // BindingJoystickButton and BindingJoystickAxis
+	JButton  uint         `json:"jbutton,omitempty"`  // BindingJoystickButton
+	Axis     JoystickAxis `json:"axis,omitempty"`     // BindingJoystickAxis
+	Inverted bool         `json:"inverted,omitempty"` // BindingJoystickAxis
+}
+
+// Action is a named input with any number of bindings
+type Action struct {
+	Name     string
+	Bindings []Binding
+	DeadZone float32 // Joystick axis values below this, in range [0 .. 1], read as 0
+}
+
+// ActionMap evaluates named actions from an InputState
+//
+// The map must see the events too (through HandleEvent or Attach)
+// for Listen to work.
+type ActionMap struct {
+	input   *InputState
+	actions map[string]*Action
+	listen  func(Binding)
+}
+
+type actionMapFile struct {
+	Version int                      `json:"version"`
+	Actions map[string]actionMapItem `json:"actions"`
+}
+
+type actionMapItem struct {
+	DeadZone float32   `json:"deadZone,omitempty"`
+	Bindings []Binding `json:"bindings"`
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a binding to a keyboard key
+func KeyBinding(key KeyCode) Binding {
+	return Binding{Kind: BindingKey, Key: key}
+}
+
+// Create a binding to a mouse button
+func MouseButtonBinding(button MouseButton) Binding {
+	return Binding{Kind: BindingMouseButton, Button: button}
+}
+
+// Create a binding to a joystick button
+func JoystickButtonBinding(joystick, button uint) Binding {
+	return Binding{Kind: BindingJoystickButton, Joystick: joystick, JButton: button}
+}
+
+// Create a binding to a joystick axis
+func JoystickAxisBinding(joystick uint, axis JoystickAxis, inverted bool) Binding {
+	return Binding{Kind: BindingJoystickAxis, Joystick: joystick, Axis: axis, Inverted: inverted}
+}
+
+// Create an axis from two keys, negative gives -1 and positive gives 1
+func KeyAxisBinding(negative, positive KeyCode) Binding {
+	return Binding{Kind: BindingKeyAxis, Negative: negative, Positive: positive}
+}
+
+func (this BindingKind) String() string {
+	if this >= 0 && int(this) < len(bindingKindNames) {
+		return bindingKindNames[this]
+	}
+	return "unknown"
+}
+
+func (this BindingKind) MarshalJSON() ([]byte, error) {
+	if this < 0 || int(this) >= len(bindingKindNames) {
+		return nil, errors.New("BindingKind.MarshalJSON: invalid binding kind")
+	}
+	return json.Marshal(bindingKindNames[this])
+}
+
+func (this *BindingKind) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	for kind, kindName := range bindingKindNames {
+		if kindName == name {
+			*this = BindingKind(kind)
+			return nil
+		}
+	}
+	return errors.New("BindingKind.UnmarshalJSON: unknown binding kind \"" + name + "\"")
+}
+
+// Create an action map reading from input
+func NewActionMap(input *InputState) *ActionMap {
+	return &ActionMap{input: input, actions: make(map[string]*Action)}
+}
+
+// Define an action, replacing any action with the same name
+func (this *ActionMap) Define(name string, deadZone float32, bindings ...Binding) *Action {
+	action := &Action{Name: name, Bindings: bindings, DeadZone: deadZone}
+	this.actions[name] = action
+	return action
+}
+
+// Get an action by name, nil if it is not defined
+func (this *ActionMap) Action(name string) *Action {
+	return this.actions[name]
+}
+
+// Get the names of all the defined actions, sorted
+func (this *ActionMap) Actions() []string {
+	names := make([]string, 0, len(this.actions))
+	for name := range this.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Add a binding to an action, defining the action if needed
+func (this *ActionMap) Bind(name string, binding Binding) {
+	action := this.actions[name]
+	if action == nil {
+		action = this.Define(name, 0)
+	}
+	action.Bindings = append(action.Bindings, binding)
+}
+
+// Remove all the bindings of an action equal to binding
+func (this *ActionMap) Unbind(name string, binding Binding) {
+	action := this.actions[name]
+	if action == nil {
+		return
+	}
+
+	bindings := action.Bindings[:0]
+	for _, b := range action.Bindings {
+		if b != binding {
+			bindings = append(bindings, b)
+		}
+	}
+	action.Bindings = bindings
+}
+
+// Get the value of an action
+//
+// Buttons and keys give 0 or 1, axes a value in [-1 .. 1] with
+// the dead zone applied. If several bindings are active, the one
+// with the largest magnitude wins.
+func (this *ActionMap) Value(name string) float32 {
+	action := this.actions[name]
+	if action == nil {
+		return 0
+	}
+
+	var value float32
+	for _, binding := range action.Bindings {
+		if v := this.bindingValue(binding, action.DeadZone); abs32(v) > abs32(value) {
+			value = v
+		}
+	}
+	return value
+}
+
+// Tell whether any binding of an action is active
+func (this *ActionMap) Held(name string) bool {
+	return this.Value(name) != 0
+}
+
+// Tell whether a key or button bound to an action went down during the current frame
+//
+// Axis bindings are not considered.
+func (this *ActionMap) JustPressed(name string) bool {
+	action := this.actions[name]
+	if action == nil {
+		return false
+	}
+
+	for _, binding := range action.Bindings {
+		switch binding.Kind {
+		case BindingKey:
+			if this.input.KeyJustPressed(binding.Key) {
+				return true
+			}
+		case BindingMouseButton:
+			if this.input.MouseButtonJustPressed(binding.Button) {
+				return true
+			}
+		case BindingJoystickButton:
+			if this.input.JoystickButtonJustPressed(binding.Joystick, binding.JButton) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Tell whether a key or button bound to an action went up during the current frame
+//
+// Axis bindings are not considered.
+func (this *ActionMap) JustReleased(name string) bool {
+	action := this.actions[name]
+	if action == nil {
+		return false
+	}
+
+	for _, binding := range action.Bindings {
+		switch binding.Kind {
+		case BindingKey:
+			if this.input.KeyJustReleased(binding.Key) {
+				return true
+			}
+		case BindingMouseButton:
+			if this.input.MouseButtonJustReleased(binding.Button) {
+				return true
+			}
+		case BindingJoystickButton:
+			if this.input.JoystickButtonJustReleased(binding.Joystick, binding.JButton) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/////////////////////////////////////
+// Rebinding
+
+// Wait for the next input and pass it to done as a binding
+//
+// The next key press, mouse button press, joystick button press or
+// joystick axis moved past half its range is turned into a binding,
+// and the event is consumed. A pending Listen is replaced.
+func (this *ActionMap) Listen(done func(Binding)) {
+	this.listen = done
+}
+
+// Wait for the next input and use it as the index-th binding of an action
+//
+// If index is out of range, the binding is appended. done is
+// called with the new binding, it can be nil.
+func (this *ActionMap) Rebind(name string, index int, done func(Binding)) {
+	this.Listen(func(binding Binding) {
+		action := this.actions[name]
+		if action == nil {
+			action = this.Define(name, 0)
+		}
+
+		if index >= 0 && index < len(action.Bindings) {
+			action.Bindings[index] = binding
+		} else {
+			action.Bindings = append(action.Bindings, binding)
+		}
+
+		if done != nil {
+			done(binding)
+		}
+	})
+}
+
+// Stop waiting for an input started by Listen or Rebind
+func (this *ActionMap) CancelListen() {
+	this.listen = nil
+}
+
+// Tell whether the map is waiting for an input
+func (this *ActionMap) IsListening() bool {
+	return this.listen != nil
+}
+
+// Pass an event to the map
+//
+// returns true if the event was consumed by Listen.
+func (this *ActionMap) HandleEvent(ev Event) bool {
+	if this.listen == nil {
+		return false
+	}
+
+	var binding Binding
+	switch ev := ev.(type) {
+	case EventKeyPressed:
+		binding = KeyBinding(ev.Code)
+	case EventMouseButtonPressed:
+		binding = MouseButtonBinding(ev.Button)
+	case EventJoystickButtonPressed:
+		binding = JoystickButtonBinding(ev.JoystickId, ev.Button)
+	case EventJoystickMoved:
+		if abs32(ev.Position) < listenAxisThreshold {
+			return false
+		}
+		binding = JoystickAxisBinding(ev.JoystickId, ev.Axis, ev.Position < 0)
+	default:
+		return false
+	}
+
+	done := this.listen
+	this.listen = nil
+	done(binding)
+	return true
+}
+
+// Attach the map to an event dispatcher, see HandleEvent
+func (this *ActionMap) Attach(dispatcher *EventDispatcher, priority int) EventHandlerId {
+	return dispatcher.OnAny(priority, this.HandleEvent)
+}
+
+/////////////////////////////////////
+// Serialisation
+
+// Write the actions and their bindings as JSON
+func (this *ActionMap) Save(w io.Writer) error {
+	file := actionMapFile{Version: actionMapVersion, Actions: make(map[string]actionMapItem, len(this.actions))}
+	for name, action := range this.actions {
+		file.Actions[name] = actionMapItem{DeadZone: action.DeadZone, Bindings: action.Bindings}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(&file)
+}
+
+// Read actions and their bindings written by Save
+//
+// Actions in the file replace the ones with the same name,
+// other actions are kept.
+func (this *ActionMap) Load(r io.Reader) error {
+	var file actionMapFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return err
+	}
+
+	if file.Version != actionMapVersion {
+		return errors.New("ActionMap.Load: unsupported version")
+	}
+
+	for name, item := range file.Actions {
+		this.Define(name, item.DeadZone, item.Bindings...)
+	}
+	return nil
+}
+
+/////////////////////////////////////
+// Helpers
+
+func (this *ActionMap) bindingValue(binding Binding, deadZone float32) float32 {
+	switch binding.Kind {
+	case BindingKey:
+		if this.input.KeyHeld(binding.Key) {
+			return 1
+		}
+	case BindingMouseButton:
+		if this.input.MouseButtonHeld(binding.Button) {
+			return 1
+		}
+	case BindingJoystickButton:
+		if this.input.JoystickButtonHeld(binding.Joystick, binding.JButton) {
+			return 1
+		}
+	case BindingKeyAxis:
+		var value float32
+		if this.input.KeyHeld(binding.Negative) {
+			value--
+		}
+		if this.input.KeyHeld(binding.Positive) {
+			value++
+		}
+		return value
+	case BindingJoystickAxis:
+		value := this.input.JoystickAxisPosition(binding.Joystick, binding.Axis) / 100
+		if binding.Inverted {
+			value = -value
+		}
+		return applyDeadZone(value, deadZone)
+	}
+	return 0
+}
+
+// Zero values within deadZone and rescale the rest to [-1 .. 1]
+func applyDeadZone(value, deadZone float32) float32 {
+	magnitude := abs32(value)
+	if magnitude <= deadZone {
+		return 0
+	}
+	if deadZone >= 1 {
+		return 0
+	}
+
+	magnitude = (magnitude - deadZone) / (1 - deadZone)
+	if magnitude > 1 {
+		magnitude = 1
+	}
+	return float32(math.Copysign(float64(magnitude), float64(value)))
+}
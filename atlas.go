@@ -0,0 +1,533 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+const (
+	atlasManifestVersion = 1
+
+	// Page size used when the maximum texture size cannot be queried
+	atlasDefaultMaxSize = 4096
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Options of an AtlasBuilder, the zero value packs images
+// as they are into pages as large as the GPU allows
+type AtlasOptions struct {
+	MaxSize       uint // Largest width and height of a page, 0 for GetMaximumTextureSize() (4096 without a display)
+	Padding       uint // Transparent pixels between two regions
+	Extrude       uint // Number of times the border pixels of a region are repeated around it
+	Trim          bool // Remove the transparent borders of the images
+	AllowRotation bool // Allow storing an image rotated by 90 degrees clockwise if it packs better
+	PowerOfTwo    bool // Round the size of the pages up to powers of two
+}
+
+// AtlasRegion is the place of an image in an atlas
+//
+// A rotated region holds the image turned by 90 degrees clockwise,
+// a sprite showing it must be rotated by -90 degrees.
+type AtlasRegion struct {
+	Page       int      // Index of the page holding the region
+	Rect       IntRect  // Area of the page, its size is swapped if Rotated
+	Rotated    bool     // The image is stored rotated by 90 degrees clockwise
+	Offset     Vector2i // Position of the stored area in the original image, non zero if trimmed
+	SourceSize Vector2u // Size of the original image, before trimming
+}
+
+// Atlas holds packed pages and the regions of the images in them
+type Atlas struct {
+	Pages   []*Image
+	Regions map[string]AtlasRegion
+}
+
+// AtlasBuilder packs images into an Atlas with the MaxRects algorithm
+type AtlasBuilder struct {
+	options AtlasOptions
+	entries []atlasEntry
+	names   map[string]bool
+}
+
+// TextureRegion is a region of an atlas page loaded in a texture
+type TextureRegion struct {
+	Texture    *Texture
+	Rect       IntRect  // Texture rect to show, see AtlasRegion for rotated regions
+	Rotated    bool     // The image is stored rotated by 90 degrees clockwise
+	Offset     Vector2i // Position of the stored area in the original image
+	SourceSize Vector2u // Size of the original image, before trimming
+}
+
+// TextureAtlas holds the pages of an atlas as textures
+type TextureAtlas struct {
+	Textures []*Texture
+	Regions  map[string]TextureRegion
+}
+
+// Image waiting to be packed
+type atlasEntry struct {
+	name       string
+	pixels     *pixelBuffer // pixels to store, trimmed if requested
+	offset     Vector2i
+	sourceSize Vector2u
+}
+
+// Free space of a page, tracked as maximal free rectangles
+type maxRectsPacker struct {
+	free []IntRect
+}
+
+// JSON manifest written by Atlas.Save
+type atlasManifest struct {
+	Version int                            `json:"version"`
+	Pages   []string                       `json:"pages"`
+	Regions map[string]atlasManifestRegion `json:"regions"`
+}
+
+type atlasManifestRegion struct {
+	Page         int  `json:"page"`
+	X            int  `json:"x"`
+	Y            int  `json:"y"`
+	Width        int  `json:"width"`
+	Height       int  `json:"height"`
+	Rotated      bool `json:"rotated,omitempty"`
+	OffsetX      int  `json:"offsetX,omitempty"`
+	OffsetY      int  `json:"offsetY,omitempty"`
+	SourceWidth  uint `json:"sourceWidth"`
+	SourceHeight uint `json:"sourceHeight"`
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create an atlas builder
+func NewAtlasBuilder(options AtlasOptions) *AtlasBuilder {
+	return &AtlasBuilder{options: options, names: make(map[string]bool)}
+}
+
+// Add an image to pack
+//
+// The pixels are copied, the image can be modified or dropped afterwards.
+//
+// 	name:  Name of the region, must be unique
+// 	image: Image to pack
+func (this *AtlasBuilder) Add(name string, image *Image) error {
+	if this.names[name] {
+		return errors.New("AtlasBuilder.Add: duplicate region name " + strconv.Quote(name))
+	}
+
+	pixels := image.readPixels()
+	if pixels.width == 0 || pixels.height == 0 {
+		return errors.New("AtlasBuilder.Add: image " + strconv.Quote(name) + " is empty")
+	}
+
+	entry := atlasEntry{name: name, pixels: pixels, sourceSize: Vector2u{uint(pixels.width), uint(pixels.height)}}
+	if this.options.Trim {
+		area := pixels.opaqueBounds()
+		entry.pixels = pixels.crop(area)
+		entry.offset = Vector2i{area.Left, area.Top}
+	}
+
+	this.names[name] = true
+	this.entries = append(this.entries, entry)
+	return nil
+}
+
+// Pack the images added so far
+//
+// Images are packed from the largest to the smallest; a new page is
+// started when an image fits in none of the previous ones. An error
+// is returned if an image is larger than a page.
+func (this *AtlasBuilder) Build() (*Atlas, error) {
+	maxSize := int(this.options.MaxSize)
+	if maxSize == 0 {
+		maxSize = atlasDefaultMaxSize
+		if HeadlessAvailable() {
+			maxSize = int(GetMaximumTextureSize())
+		}
+	}
+	extrude, padding := int(this.options.Extrude), int(this.options.Padding)
+
+	// largest side first, then largest area, then name to stay deterministic
+	order := make([]int, len(this.entries))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		a, b := this.entries[order[i]].pixels, this.entries[order[j]].pixels
+		if sideA, sideB := maxInt(a.width, a.height), maxInt(b.width, b.height); sideA != sideB {
+			return sideA > sideB
+		}
+		if areaA, areaB := a.width*a.height, b.width*b.height; areaA != areaB {
+			return areaA > areaB
+		}
+		return this.entries[order[i]].name < this.entries[order[j]].name
+	})
+
+	// cells include the extrusion and the padding on their right and bottom,
+	// the bins are enlarged by the padding so that it may overflow the page
+	type placement struct {
+		page    int
+		cell    IntRect
+		rotated bool
+	}
+	placements := make([]placement, len(this.entries))
+	var packers []*maxRectsPacker
+
+	for _, index := range order {
+		entry := &this.entries[index]
+		width, height := entry.pixels.width+2*extrude, entry.pixels.height+2*extrude
+		if width > maxSize || height > maxSize {
+			return nil, errors.New("AtlasBuilder.Build: image " + strconv.Quote(entry.name) + " (" + strconv.Itoa(width) + "x" + strconv.Itoa(height) +
+				" with extrusion) does not fit in a " + strconv.Itoa(maxSize) + "x" + strconv.Itoa(maxSize) + " page")
+		}
+
+		placed := false
+		for page, packer := range packers {
+			if cell, rotated, ok := packer.insert(width+padding, height+padding, this.options.AllowRotation); ok {
+				placements[index] = placement{page, cell, rotated}
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			packer := newMaxRectsPacker(maxSize+padding, maxSize+padding)
+			cell, rotated, _ := packer.insert(width+padding, height+padding, this.options.AllowRotation)
+			placements[index] = placement{len(packers), cell, rotated}
+			packers = append(packers, packer)
+		}
+	}
+
+	// size the pages to their content
+	sizes := make([]Vector2i, len(packers))
+	for _, p := range placements {
+		sizes[p.page].X = maxInt(sizes[p.page].X, p.cell.Left+p.cell.Width-padding)
+		sizes[p.page].Y = maxInt(sizes[p.page].Y, p.cell.Top+p.cell.Height-padding)
+	}
+	buffers := make([]*pixelBuffer, len(packers))
+	for i, size := range sizes {
+		if this.options.PowerOfTwo {
+			size.X, size.Y = nextPowerOfTwo(size.X), nextPowerOfTwo(size.Y)
+		}
+		buffers[i] = newPixelBuffer(size.X, size.Y)
+	}
+
+	atlas := &Atlas{Regions: make(map[string]AtlasRegion, len(this.entries))}
+	for i, p := range placements {
+		entry := &this.entries[i]
+		pixels := entry.pixels
+		if p.rotated {
+			pixels = pixels.rotateQuarter(true, rotateClockwise)
+		}
+
+		left, top := p.cell.Left+extrude, p.cell.Top+extrude
+		buffers[p.page].drawExtruded(pixels, left, top, extrude)
+
+		atlas.Regions[entry.name] = AtlasRegion{
+			Page:       p.page,
+			Rect:       IntRect{left, top, pixels.width, pixels.height},
+			Rotated:    p.rotated,
+			Offset:     entry.offset,
+			SourceSize: entry.sourceSize,
+		}
+	}
+	for _, buffer := range buffers {
+		atlas.Pages = append(atlas.Pages, buffer.toImage())
+	}
+
+	return atlas, nil
+}
+
+// Get the names of the regions, sorted
+func (this *Atlas) Names() []string {
+	names := make([]string, 0, len(this.Regions))
+	for name := range this.Regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Write the pages as PNG files and the JSON manifest describing them
+//
+// The pages are written next to the manifest, named after it:
+// sprites.json gets sprites_0.png, sprites_1.png and so on.
+//
+// 	manifestPath: Path of the JSON manifest to write
+func (this *Atlas) Save(manifestPath string) error {
+	dir := filepath.Dir(manifestPath)
+	base := strings.TrimSuffix(filepath.Base(manifestPath), filepath.Ext(manifestPath))
+
+	pageFiles := make([]string, len(this.Pages))
+	for i, page := range this.Pages {
+		pageFiles[i] = base + "_" + strconv.Itoa(i) + ".png"
+		if err := page.SaveToFile(filepath.Join(dir, pageFiles[i])); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Create(manifestPath)
+	if err != nil {
+		return err
+	}
+	if err := this.WriteManifest(file, pageFiles); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Write the JSON manifest of the atlas
+//
+// 	w:         Destination of the manifest
+// 	pageFiles: File of each page, relative to the manifest
+func (this *Atlas) WriteManifest(w io.Writer, pageFiles []string) error {
+	if len(pageFiles) != len(this.Pages) {
+		return errors.New("Atlas.WriteManifest: one file per page is needed")
+	}
+
+	manifest := atlasManifest{Version: atlasManifestVersion, Pages: pageFiles, Regions: make(map[string]atlasManifestRegion, len(this.Regions))}
+	for name, region := range this.Regions {
+		manifest.Regions[name] = atlasManifestRegion{
+			Page:         region.Page,
+			X:            region.Rect.Left,
+			Y:            region.Rect.Top,
+			Width:        region.Rect.Width,
+			Height:       region.Rect.Height,
+			Rotated:      region.Rotated,
+			OffsetX:      region.Offset.X,
+			OffsetY:      region.Offset.Y,
+			SourceWidth:  region.SourceSize.X,
+			SourceHeight: region.SourceSize.Y,
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(&manifest)
+}
+
+// Upload the pages of an atlas to textures
+func NewTextureAtlas(atlas *Atlas) (*TextureAtlas, error) {
+	textures := make([]*Texture, len(atlas.Pages))
+	for i, page := range atlas.Pages {
+		texture, err := NewTextureFromImage(page, nil)
+		if err != nil {
+			return nil, err
+		}
+		textures[i] = texture
+	}
+
+	textureAtlas := &TextureAtlas{Textures: textures, Regions: make(map[string]TextureRegion, len(atlas.Regions))}
+	for name, region := range atlas.Regions {
+		textureAtlas.Regions[name] = region.toTextureRegion(textures)
+	}
+	return textureAtlas, nil
+}
+
+// Load an atlas written by Atlas.Save or the atlaspack tool
+//
+// 	manifestPath: Path of the JSON manifest, the pages are looked up next to it
+func LoadTextureAtlas(manifestPath string) (*TextureAtlas, error) {
+	file, err := os.Open(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var manifest atlasManifest
+	if err := json.NewDecoder(file).Decode(&manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version != atlasManifestVersion {
+		return nil, errors.New("LoadTextureAtlas: unsupported version")
+	}
+
+	dir := filepath.Dir(manifestPath)
+	textures := make([]*Texture, len(manifest.Pages))
+	for i, page := range manifest.Pages {
+		texture, err := NewTextureFromFile(filepath.Join(dir, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		textures[i] = texture
+	}
+
+	textureAtlas := &TextureAtlas{Textures: textures, Regions: make(map[string]TextureRegion, len(manifest.Regions))}
+	for name, item := range manifest.Regions {
+		if item.Page < 0 || item.Page >= len(textures) {
+			return nil, errors.New("LoadTextureAtlas: region " + strconv.Quote(name) + " refers to missing page " + strconv.Itoa(item.Page))
+		}
+		region := AtlasRegion{
+			Page:       item.Page,
+			Rect:       IntRect{item.X, item.Y, item.Width, item.Height},
+			Rotated:    item.Rotated,
+			Offset:     Vector2i{item.OffsetX, item.OffsetY},
+			SourceSize: Vector2u{item.SourceWidth, item.SourceHeight},
+		}
+		textureAtlas.Regions[name] = region.toTextureRegion(textures)
+	}
+	return textureAtlas, nil
+}
+
+// Get a region by name
+func (this *TextureAtlas) Region(name string) (region TextureRegion, ok bool) {
+	region, ok = this.Regions[name]
+	return
+}
+
+// Show a region on a sprite
+//
+// The texture and the texture rect of the sprite are replaced,
+// its transform is left unchanged.
+func (this *TextureRegion) ApplyTo(sprite *Sprite) {
+	sprite.SetTexture(this.Texture, false)
+	sprite.SetTextureRect(this.Rect)
+}
+
+func (this *AtlasRegion) toTextureRegion(textures []*Texture) TextureRegion {
+	return TextureRegion{
+		Texture:    textures[this.Page],
+		Rect:       this.Rect,
+		Rotated:    this.Rotated,
+		Offset:     this.Offset,
+		SourceSize: this.SourceSize,
+	}
+}
+
+/////////////////////////////////////
+///		MAXRECTS
+/////////////////////////////////////
+
+func newMaxRectsPacker(width, height int) *maxRectsPacker {
+	return &maxRectsPacker{free: []IntRect{{0, 0, width, height}}}
+}
+
+// Find a place for a rectangle with the best short side fit heuristic and reserve it
+func (this *maxRectsPacker) insert(width, height int, allowRotation bool) (rect IntRect, rotated, ok bool) {
+	bestShort, bestLong := -1, -1
+	try := func(free IntRect, w, h int, rot bool) {
+		if w > free.Width || h > free.Height {
+			return
+		}
+		leftX, leftY := free.Width-w, free.Height-h
+		short, long := minInt(leftX, leftY), maxInt(leftX, leftY)
+		if bestShort < 0 || short < bestShort || (short == bestShort && long < bestLong) {
+			bestShort, bestLong = short, long
+			rect, rotated, ok = IntRect{free.Left, free.Top, w, h}, rot, true
+		}
+	}
+
+	for _, free := range this.free {
+		try(free, width, height, false)
+		if allowRotation && width != height {
+			try(free, height, width, true)
+		}
+	}
+
+	if ok {
+		this.place(rect)
+	}
+	return
+}
+
+// Split the free rectangles overlapping used and drop the redundant ones
+func (this *maxRectsPacker) place(used IntRect) {
+	usedRight, usedBottom := used.Left+used.Width, used.Top+used.Height
+
+	free := make([]IntRect, 0, len(this.free)+4)
+	for _, rect := range this.free {
+		right, bottom := rect.Left+rect.Width, rect.Top+rect.Height
+		if used.Left >= right || usedRight <= rect.Left || used.Top >= bottom || usedBottom <= rect.Top {
+			free = append(free, rect)
+			continue
+		}
+
+		if used.Left > rect.Left {
+			free = append(free, IntRect{rect.Left, rect.Top, used.Left - rect.Left, rect.Height})
+		}
+		if usedRight < right {
+			free = append(free, IntRect{usedRight, rect.Top, right - usedRight, rect.Height})
+		}
+		if used.Top > rect.Top {
+			free = append(free, IntRect{rect.Left, rect.Top, rect.Width, used.Top - rect.Top})
+		}
+		if usedBottom < bottom {
+			free = append(free, IntRect{rect.Left, usedBottom, rect.Width, bottom - usedBottom})
+		}
+	}
+
+	// keep only the maximal rectangles
+	this.free = this.free[:0]
+	for i, rect := range free {
+		contained := false
+		for j, other := range free {
+			if i != j && other.ContainsRect(rect) && (rect != other || j < i) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			this.free = append(this.free, rect)
+		}
+	}
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Bounds of the pixels which are not fully transparent, at least 1x1
+func (this *pixelBuffer) opaqueBounds() IntRect {
+	minX, minY, maxX, maxY := this.width, this.height, -1, -1
+	for y := 0; y < this.height; y++ {
+		for x := 0; x < this.width; x++ {
+			if this.pix[(y*this.width+x)*4+3] != 0 {
+				minX, minY = minInt(minX, x), minInt(minY, y)
+				maxX, maxY = maxInt(maxX, x), maxInt(maxY, y)
+			}
+		}
+	}
+
+	if maxX < 0 {
+		return IntRect{0, 0, 1, 1}
+	}
+	return IntRect{minX, minY, maxX - minX + 1, maxY - minY + 1}
+}
+
+// Copy src at (left, top), repeating its border pixels extrude times around it
+func (this *pixelBuffer) drawExtruded(src *pixelBuffer, left, top, extrude int) {
+	for y := -extrude; y < src.height+extrude; y++ {
+		sy := maxInt(0, minInt(y, src.height-1))
+		for x := -extrude; x < src.width+extrude; x++ {
+			sx := maxInt(0, minInt(x, src.width-1))
+			i := ((top+y)*this.width + left + x) * 4
+			j := (sy*src.width + sx) * 4
+			copy(this.pix[i:i+4], src.pix[j:j+4])
+		}
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	power := 1
+	for power < n {
+		power <<= 1
+	}
+	return power
+}
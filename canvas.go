@@ -0,0 +1,498 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sort"
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Canvas is a drawing surface on a Go side RGBA pixel buffer
+//
+// Drawing on a Canvas never goes through cgo. When done, Commit
+// or ToImage copies the whole buffer to a native Image at once.
+// Shapes are alpha blended over the existing pixels, while Clear,
+// SetPixel and FloodFill overwrite them.
+type Canvas struct {
+	pixelBuffer
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a transparent canvas
+//
+// 	width:  Width of the canvas
+// 	height: Height of the canvas
+func NewCanvas(width, height uint) *Canvas {
+	return &Canvas{*newPixelBuffer(int(width), int(height))}
+}
+
+// Create a canvas holding a copy of the pixels of an image
+//
+// 	image: Image to copy
+func NewCanvasFromImage(image *Image) *Canvas {
+	return &Canvas{*image.readPixels()}
+}
+
+// Return the size of a canvas
+func (this *Canvas) GetSize() Vector2u {
+	return Vector2u{uint(this.width), uint(this.height)}
+}
+
+// Return the pixels of a canvas, RGBA and not premultiplied
+//
+// The slice is the canvas' own buffer, changing it changes the canvas.
+func (this *Canvas) GetPixelData() []byte {
+	return this.pix
+}
+
+// Copy the canvas to an existing image, replacing its pixels
+// and size
+//
+// 	image: Destination image
+func (this *Canvas) Commit(image *Image) {
+	image.writePixels(&this.pixelBuffer)
+}
+
+// Create a new image holding a copy of the canvas
+func (this *Canvas) ToImage() *Image {
+	return this.toImage()
+}
+
+// Fill the whole canvas with a color
+func (this *Canvas) Clear(color Color) {
+	for i := 0; i < len(this.pix); i += 4 {
+		this.pix[i], this.pix[i+1], this.pix[i+2], this.pix[i+3] = color.R, color.G, color.B, color.A
+	}
+}
+
+// Return the color of a pixel, Color{} outside of the canvas
+func (this *Canvas) GetPixel(x, y int) Color {
+	if !this.contains(x, y) {
+		return Color{}
+	}
+	p := this.pix[(y*this.width+x)*4:]
+	return Color{p[0], p[1], p[2], p[3]}
+}
+
+// Replace the color of a pixel, coordinates outside of the
+// canvas are ignored
+func (this *Canvas) SetPixel(x, y int, color Color) {
+	if !this.contains(x, y) {
+		return
+	}
+	p := this.pix[(y*this.width+x)*4:]
+	p[0], p[1], p[2], p[3] = color.R, color.G, color.B, color.A
+}
+
+// Blend a color over a pixel, coordinates outside of the
+// canvas are ignored
+func (this *Canvas) BlendPixel(x, y int, color Color) {
+	if !this.contains(x, y) || color.A == 0 {
+		return
+	}
+
+	p := this.pix[(y*this.width+x)*4:]
+	if color.A == 255 {
+		p[0], p[1], p[2], p[3] = color.R, color.G, color.B, 255
+		return
+	}
+
+	// source over, not premultiplied
+	sa := uint32(color.A)
+	da := uint32(p[3]) * (255 - sa) / 255
+	oa := sa + da
+	p[0] = uint8((uint32(color.R)*sa + uint32(p[0])*da + oa/2) / oa)
+	p[1] = uint8((uint32(color.G)*sa + uint32(p[1])*da + oa/2) / oa)
+	p[2] = uint8((uint32(color.B)*sa + uint32(p[2])*da + oa/2) / oa)
+	p[3] = uint8(oa)
+}
+
+// Draw an aliased line, both end points included
+//
+// 	from:  Start point
+// 	to:    End point
+// 	color: Line color
+func (this *Canvas) DrawLine(from, to Vector2f, color Color) {
+	x0, y0 := roundInt(from.X), roundInt(from.Y)
+	x1, y1 := roundInt(to.X), roundInt(to.Y)
+
+	// Bresenham
+	dx, dy := absInt(x1-x0), -absInt(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		this.BlendPixel(x0, y0, color)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// Draw an antialiased one pixel wide line
+//
+// The end points are in pixel coordinates, pixel (x, y) covering
+// [x, x+1[ * [y, y+1[.
+//
+// 	from:  Start point
+// 	to:    End point
+// 	color: Line color
+func (this *Canvas) DrawLineAA(from, to Vector2f, color Color) {
+	// Xiaolin Wu, on pixel centers
+	x0, y0 := float64(from.X)-0.5, float64(from.Y)-0.5
+	x1, y1 := float64(to.X)-0.5, float64(to.Y)-0.5
+
+	steep := math.Abs(y1-y0) > math.Abs(x1-x0)
+	if steep {
+		x0, y0, x1, y1 = y0, x0, y1, x1
+	}
+	if x0 > x1 {
+		x0, x1, y0, y1 = x1, x0, y1, y0
+	}
+
+	plot := func(x, y int, coverage float64) {
+		if steep {
+			x, y = y, x
+		}
+		c := color
+		c.A = uint8(float64(c.A)*coverage + 0.5)
+		this.BlendPixel(x, y, c)
+	}
+
+	gradient := 1.0
+	if x1 != x0 {
+		gradient = (y1 - y0) / (x1 - x0)
+	}
+
+	// end points, weighted by how much of their pixel they cover
+	endPoint := func(x, y float64, first bool) (int, float64) {
+		xEnd := math.Floor(x + 0.5)
+		yEnd := y + gradient*(xEnd-x)
+		xGap := 1 - frac(x+0.5)
+		if !first {
+			xGap = frac(x + 0.5)
+		}
+		px, py := int(xEnd), int(math.Floor(yEnd))
+		plot(px, py, (1-frac(yEnd))*xGap)
+		plot(px, py+1, frac(yEnd)*xGap)
+		return px, yEnd
+	}
+
+	start, yEnd := endPoint(x0, y0, true)
+	end, _ := endPoint(x1, y1, false)
+
+	intery := yEnd + gradient
+	for x := start + 1; x < end; x++ {
+		y := int(math.Floor(intery))
+		plot(x, y, 1-frac(intery))
+		plot(x, y+1, frac(intery))
+		intery += gradient
+	}
+}
+
+// Draw the one pixel wide outline of a rectangle, inside the rectangle
+func (this *Canvas) DrawRect(rect IntRect, color Color) {
+	minX, minY, maxX, maxY := rect.bounds()
+	if minX == maxX || minY == maxY {
+		return
+	}
+
+	this.FillRect(IntRect{minX, minY, maxX - minX, 1}, color)
+	if maxY-minY > 1 {
+		this.FillRect(IntRect{minX, maxY - 1, maxX - minX, 1}, color)
+	}
+	if maxY-minY > 2 {
+		this.FillRect(IntRect{minX, minY + 1, 1, maxY - minY - 2}, color)
+		if maxX-minX > 1 {
+			this.FillRect(IntRect{maxX - 1, minY + 1, 1, maxY - minY - 2}, color)
+		}
+	}
+}
+
+// Fill a rectangle
+func (this *Canvas) FillRect(rect IntRect, color Color) {
+	_, area := rect.Intersects(IntRect{0, 0, this.width, this.height})
+	for y := area.Top; y < area.Top+area.Height; y++ {
+		for x := area.Left; x < area.Left+area.Width; x++ {
+			this.BlendPixel(x, y, color)
+		}
+	}
+}
+
+// Draw the one pixel wide outline of a circle
+//
+// 	center: Center of the circle, rounded to the closest pixel
+// 	radius: Radius, rounded to whole pixels
+// 	color:  Outline color
+func (this *Canvas) DrawCircle(center Vector2f, radius float32, color Color) {
+	cx, cy, r := roundInt(center.X), roundInt(center.Y), roundInt(radius)
+	if r <= 0 {
+		this.BlendPixel(cx, cy, color)
+		return
+	}
+
+	// midpoint circle, the octants meet on the axes and diagonals
+	// so plotted pixels are remembered to blend each of them once
+	plotted := make(map[[2]int]bool)
+	x, y, err := r, 0, 1-r
+	for x >= y {
+		for _, p := range [8][2]int{{x, y}, {y, x}, {-y, x}, {-x, y}, {-x, -y}, {-y, -x}, {y, -x}, {x, -y}} {
+			if !plotted[p] {
+				plotted[p] = true
+				this.BlendPixel(cx+p[0], cy+p[1], color)
+			}
+		}
+
+		y++
+		if err < 0 {
+			err += 2*y + 1
+		} else {
+			x--
+			err += 2*(y-x) + 1
+		}
+	}
+}
+
+// Fill a circle, covering the pixels whose center is inside it
+//
+// 	center: Center of the circle
+// 	radius: Radius of the circle
+// 	color:  Fill color
+func (this *Canvas) FillCircle(center Vector2f, radius float32, color Color) {
+	if radius <= 0 {
+		return
+	}
+
+	cx, cy, r := float64(center.X), float64(center.Y), float64(radius)
+	top := maxInt(int(math.Ceil(cy-r-0.5)), 0)
+	bottom := minInt(int(math.Floor(cy+r-0.5)), this.height-1)
+
+	for y := top; y <= bottom; y++ {
+		dy := float64(y) + 0.5 - cy
+		dx := math.Sqrt(math.Max(r*r-dy*dy, 0))
+		this.fillSpan(y, cx-dx, cx+dx, color)
+	}
+}
+
+// Draw the one pixel wide outline of a closed polygon
+//
+// 	points: Vertices of the polygon
+// 	color:  Outline color
+func (this *Canvas) DrawPolygon(points []Vector2f, color Color) {
+	for i := range points {
+		this.DrawLine(points[i], points[(i+1)%len(points)], color)
+	}
+}
+
+// Fill a polygon, covering the pixels whose center is inside it
+//
+// The polygon may be concave or self-intersecting, the inside
+// is given by the even-odd rule.
+//
+// 	points: Vertices of the polygon
+// 	color:  Fill color
+func (this *Canvas) FillPolygon(points []Vector2f, color Color) {
+	if len(points) < 3 {
+		return
+	}
+
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points[1:] {
+		minY, maxY = min32(minY, p.Y), max32(maxY, p.Y)
+	}
+	top := maxInt(int(math.Ceil(float64(minY)-0.5)), 0)
+	bottom := minInt(int(math.Floor(float64(maxY)-0.5)), this.height-1)
+
+	var crossings []float64
+	for y := top; y <= bottom; y++ {
+		scan := float64(y) + 0.5
+
+		crossings = crossings[:0]
+		for i := range points {
+			a, b := points[i], points[(i+1)%len(points)]
+			ay, by := float64(a.Y), float64(b.Y)
+			// half-open so that shared vertices are counted once
+			if (ay <= scan) != (by <= scan) {
+				t := (scan - ay) / (by - ay)
+				crossings = append(crossings, float64(a.X)+t*float64(b.X-a.X))
+			}
+		}
+		sort.Float64s(crossings)
+
+		for i := 0; i+1 < len(crossings); i += 2 {
+			this.fillSpan(y, crossings[i], crossings[i+1], color)
+		}
+	}
+}
+
+// Replace the 4-connected area of pixels having the color of (x, y)
+//
+// 	x, y:  Seed pixel
+// 	color: New color of the area
+func (this *Canvas) FloodFill(x, y int, color Color) {
+	if !this.contains(x, y) {
+		return
+	}
+
+	target := this.GetPixel(x, y)
+	if target == color {
+		return
+	}
+
+	// scanline fill, the stack holds seeds of spans to fill
+	stack := [][2]int{{x, y}}
+	for len(stack) > 0 {
+		seed := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		sx, sy := seed[0], seed[1]
+		if this.GetPixel(sx, sy) != target {
+			continue
+		}
+
+		left := sx
+		for left > 0 && this.GetPixel(left-1, sy) == target {
+			left--
+		}
+		right := sx
+		for right < this.width-1 && this.GetPixel(right+1, sy) == target {
+			right++
+		}
+
+		for px := left; px <= right; px++ {
+			this.SetPixel(px, sy, color)
+		}
+
+		for _, ny := range [2]int{sy - 1, sy + 1} {
+			if ny < 0 || ny >= this.height {
+				continue
+			}
+			inSpan := false
+			for px := left; px <= right; px++ {
+				matches := this.GetPixel(px, ny) == target
+				if matches && !inSpan {
+					stack = append(stack, [2]int{px, ny})
+				}
+				inSpan = matches
+			}
+		}
+	}
+}
+
+// Blend a region of another canvas over this one
+//
+// 	source:     Canvas to copy from
+// 	destX:      X coordinate of the destination position
+// 	destY:      Y coordinate of the destination position
+// 	sourceRect: Region of the source to copy, empty for the whole source
+func (this *Canvas) Blit(source *Canvas, destX, destY int, sourceRect IntRect) {
+	this.blit(&source.pixelBuffer, destX, destY, sourceRect)
+}
+
+// Blend a region of an image over the canvas
+//
+// The pixels of the image are read once, in a single copy.
+//
+// 	source:     Image to copy from
+// 	destX:      X coordinate of the destination position
+// 	destY:      Y coordinate of the destination position
+// 	sourceRect: Region of the source to copy, empty for the whole source
+func (this *Canvas) BlitImage(source *Image, destX, destY int, sourceRect IntRect) {
+	this.blit(source.readPixels(), destX, destY, sourceRect)
+}
+
+// Implement image.Image
+func (this *Canvas) Bounds() image.Rectangle {
+	return image.Rect(0, 0, this.width, this.height)
+}
+
+// Implement image.Image
+func (this *Canvas) ColorModel() color.Model {
+	return ColorModel
+}
+
+// Implement image.Image
+func (this *Canvas) At(x, y int) color.Color {
+	return this.GetPixel(x, y)
+}
+
+// Implement draw.Image, the pixel is replaced
+func (this *Canvas) Set(x, y int, c color.Color) {
+	this.SetPixel(x, y, ColorModel.Convert(c).(Color))
+}
+
+func (this *Canvas) contains(x, y int) bool {
+	return x >= 0 && y >= 0 && x < this.width && y < this.height
+}
+
+// Fill the pixels of row y whose center is in [x0, x1]
+func (this *Canvas) fillSpan(y int, x0, x1 float64, color Color) {
+	first := maxInt(int(math.Ceil(x0-0.5)), 0)
+	last := minInt(int(math.Floor(x1-0.5)), this.width-1)
+	for x := first; x <= last; x++ {
+		this.BlendPixel(x, y, color)
+	}
+}
+
+func (this *Canvas) blit(source *pixelBuffer, destX, destY int, sourceRect IntRect) {
+	if sourceRect.Width == 0 || sourceRect.Height == 0 {
+		sourceRect = IntRect{0, 0, source.width, source.height}
+	}
+	_, area := sourceRect.Intersects(IntRect{0, 0, source.width, source.height})
+
+	//the part of sourceRect clipped off the source shifts the destination
+	left, top, _, _ := sourceRect.bounds()
+	destX += area.Left - left
+	destY += area.Top - top
+
+	for y := 0; y < area.Height; y++ {
+		for x := 0; x < area.Width; x++ {
+			p := source.pix[((area.Top+y)*source.width+area.Left+x)*4:]
+			this.BlendPixel(destX+x, destY+y, Color{p[0], p[1], p[2], p[3]})
+		}
+	}
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func roundInt(v float32) int {
+	return int(math.Floor(float64(v) + 0.5))
+}
+
+// Fractional part, in [0, 1[
+func frac(v float64) float64 {
+	return v - math.Floor(v)
+}
+
+/////////////////////////////////////
+///		TEST
+/////////////////////////////////////
+
+var _ draw.Image = (*Canvas)(nil)
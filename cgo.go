@@ -4,5 +4,5 @@
 
 package gosfml2
 
-// #cgo LDFLAGS: -lcsfml-window -lcsfml-graphics -lcsfml-audio -lcsfml-system
+// #cgo LDFLAGS: -lcsfml-window -lcsfml-graphics -lcsfml-audio -lcsfml-system -lsfml-system
 import "C"
@@ -17,6 +17,13 @@ import "runtime"
 type CircleShape struct {
 	cptr    *C.sfCircleShape
 	texture *Texture //to prevent the GC from deleting the texture
+
+	transformState
+	fillColor     Color
+	outlineColor  Color
+	textureRect   IntRect
+	localBounds   FloatRect
+	boundsUpdated bool
 }
 
 /////////////////////////////////////
@@ -26,7 +33,7 @@ type CircleShape struct {
 // Create a new circle shape with a given radius
 func NewCircleShape() (*CircleShape, error) {
 	if cptr := C.sfCircleShape_create(); cptr != nil {
-		shape := &CircleShape{cptr, nil}
+		shape := &CircleShape{cptr: cptr, transformState: newTransformState(), fillColor: ColorWhite(), outlineColor: ColorWhite()}
 		runtime.SetFinalizer(shape, (*CircleShape).destroy)
 		return shape, nil
 	}
@@ -35,9 +42,12 @@ func NewCircleShape() (*CircleShape, error) {
 
 // Copy an existing circle shape
 func (this *CircleShape) Copy() *CircleShape {
-	shape := &CircleShape{C.sfCircleShape_copy(this.cptr), this.texture}
-	runtime.SetFinalizer(shape, (*CircleShape).destroy)
-	return shape
+	this.flush()
+
+	shape := *this
+	shape.cptr = C.sfCircleShape_copy(this.cptr)
+	runtime.SetFinalizer(&shape, (*CircleShape).destroy)
+	return &shape
 }
 
 // Destroy an existing circle Shape
@@ -51,7 +61,7 @@ func (this *CircleShape) destroy() {
 // See sfCircleShape_move to apply an offset based on the previous position instead.
 // The default position of a circle Shape object is (0, 0).
 func (this *CircleShape) SetPosition(pos Vector2f) {
-	C.sfCircleShape_setPosition(this.cptr, pos.toC())
+	this.setPosition(pos)
 }
 
 // Set the scale factors of a circle shape
@@ -60,7 +70,7 @@ func (this *CircleShape) SetPosition(pos Vector2f) {
 // See sfCircleShape_scale to add a factor based on the previous scale instead.
 // The default scale of a circle Shape object is (1, 1).
 func (this *CircleShape) SetScale(scale Vector2f) {
-	C.sfCircleShape_setScale(this.cptr, scale.toC())
+	this.setScale(scale)
 }
 
 // Set the local origin of a circle shape
@@ -72,7 +82,7 @@ func (this *CircleShape) SetScale(scale Vector2f) {
 // transformations (position, scale, rotation).
 // The default origin of a circle Shape object is (0, 0).
 func (this *CircleShape) SetOrigin(orig Vector2f) {
-	C.sfCircleShape_setOrigin(this.cptr, orig.toC())
+	this.setOrigin(orig)
 }
 
 // Set the orientation of a circle shape
@@ -81,32 +91,29 @@ func (this *CircleShape) SetOrigin(orig Vector2f) {
 // See sfCircleShape_rotate to add an angle based on the previous rotation instead.
 // The default rotation of a circle Shape object is 0.
 func (this *CircleShape) SetRotation(rot float32) {
-	C.sfCircleShape_setRotation(this.cptr, C.float(rot))
+	this.setRotation(rot)
 }
 
 // Get the orientation of a circle shape
 //
 // The rotation is always in the range [0, 360].
 func (this *CircleShape) GetRotation() float32 {
-	return float32(C.sfCircleShape_getRotation(this.cptr))
+	return this.rotation
 }
 
 // Get the position of a circle shape
 func (this *CircleShape) GetPosition() (position Vector2f) {
-	position.fromC(C.sfCircleShape_getPosition(this.cptr))
-	return
+	return this.position
 }
 
 // Get the current scale of a circle shape
 func (this *CircleShape) GetScale() (scale Vector2f) {
-	scale.fromC(C.sfCircleShape_getScale(this.cptr))
-	return
+	return this.scale
 }
 
 // Get the local origin of a circle shape
 func (this *CircleShape) GetOrigin() (origin Vector2f) {
-	origin.fromC(C.sfCircleShape_getOrigin(this.cptr))
-	return
+	return this.origin
 }
 
 // Move a circle shape by a given offset
@@ -114,7 +121,7 @@ func (this *CircleShape) GetOrigin() (origin Vector2f) {
 // This function adds to the current position of the object,
 // unlike CircleShape.SetPosition which overwrites it.
 func (this *CircleShape) Move(offset Vector2f) {
-	C.sfCircleShape_move(this.cptr, offset.toC())
+	this.move(offset)
 }
 
 // Scale a circle shape
@@ -122,7 +129,7 @@ func (this *CircleShape) Move(offset Vector2f) {
 // This function multiplies the current scale of the object,
 // unlike CircleShape.SetScale which overwrites it.
 func (this *CircleShape) Scale(factor Vector2f) {
-	C.sfCircleShape_scale(this.cptr, factor.toC())
+	this.scaleBy(factor)
 }
 
 // Rotate a circle shape
@@ -130,7 +137,7 @@ func (this *CircleShape) Scale(factor Vector2f) {
 // This function adds to the current rotation of the object,
 // unlike CircleShape.SetRotation which overwrites it.
 func (this *CircleShape) Rotate(angle float32) {
-	C.sfCircleShape_rotate(this.cptr, C.float(angle))
+	this.rotate(angle)
 }
 
 // Change the source texture of a circle shape
@@ -143,8 +150,10 @@ func (this *CircleShape) Rotate(angle float32) {
 // 	texture:   New texture
 // 	resetRect: Should the texture rect be reset to the size of the new texture?
 func (this *CircleShape) SetTexture(texture *Texture, resetRect bool) {
+	this.flush()
 	C.sfCircleShape_setTexture(this.cptr, texture.toCPtr(), goBool2C(resetRect))
 	this.texture = texture
+	this.textureRect.fromC(C.sfCircleShape_getTextureRect(this.cptr))
 }
 
 // Set the sub-rectangle of the texture that a circle shape will display
@@ -153,7 +162,8 @@ func (this *CircleShape) SetTexture(texture *Texture, resetRect bool) {
 // the whole texture, but rather a part of it.
 // By default, the texture rect covers the entire texture.
 func (this *CircleShape) SetTextureRect(rect IntRect) {
-	C.sfCircleShape_setTextureRect(this.cptr, rect.toC())
+	this.textureRect = rect
+	this.dirty |= dirtyTextureRect
 }
 
 // Set the fill color of a circle shape
@@ -165,7 +175,8 @@ func (this *CircleShape) SetTextureRect(rect IntRect) {
 // the shape transparent, and have the outline alone.
 // By default, the shape's fill color is opaque white.
 func (this *CircleShape) SetFillColor(color Color) {
-	C.sfCircleShape_setFillColor(this.cptr, color.toC())
+	this.fillColor = color
+	this.dirty |= dirtyColor
 }
 
 // Set the outline color of a circle shape
@@ -173,7 +184,8 @@ func (this *CircleShape) SetFillColor(color Color) {
 // You can use sfTransparent to disable the outline.
 // By default, the shape's outline color is opaque white.
 func (this *CircleShape) SetOutlineColor(color Color) {
-	C.sfCircleShape_setOutlineColor(this.cptr, color.toC())
+	this.outlineColor = color
+	this.dirty |= dirtyOutlineColor
 }
 
 // Set the thickness of a circle shape's outline
@@ -183,6 +195,7 @@ func (this *CircleShape) SetOutlineColor(color Color) {
 // By default, the outline thickness is 0.
 func (this *CircleShape) SetOutlineThickness(thickness float32) {
 	C.sfCircleShape_setOutlineThickness(this.cptr, C.float(thickness))
+	this.boundsUpdated = false
 }
 
 // Get the source texture of a circle shape
@@ -196,32 +209,27 @@ func (this *CircleShape) GetTexture() *Texture {
 
 // Get the combined transform of a circle shape
 func (this *CircleShape) GetTransform() (transform Transform) {
-	transform.fromC(C.sfCircleShape_getTransform(this.cptr))
-	return
+	return this.getTransform()
 }
 
 // Get the inverse of the combined transform of a circle shape
 func (this *CircleShape) GetInverseTransform() (transform Transform) {
-	transform.fromC(C.sfCircleShape_getInverseTransform(this.cptr))
-	return
+	return this.getInverseTransform()
 }
 
 // Get the sub-rectangle of the texture displayed by a circle shape
 func (this *CircleShape) GetTextureRect() (rect IntRect) {
-	rect.fromC(C.sfCircleShape_getTextureRect(this.cptr))
-	return
+	return this.textureRect
 }
 
 // Get the fill color of a circle shape
 func (this *CircleShape) GetFillColor() (color Color) {
-	color.fromC(C.sfCircleShape_getFillColor(this.cptr))
-	return
+	return this.fillColor
 }
 
 // Get the outline color of a circle shape
 func (this *CircleShape) GetOutlineColor() (color Color) {
-	color.fromC(C.sfCircleShape_getOutlineColor(this.cptr))
-	return
+	return this.outlineColor
 }
 
 // Get the outline thickness of a circle shape
@@ -242,6 +250,7 @@ func (this *CircleShape) GetPoint(index uint) (point Vector2f) {
 // Set the radius of a circle
 func (this *CircleShape) SetRadius(radius float32) {
 	C.sfCircleShape_setRadius(this.cptr, C.float(radius))
+	this.boundsUpdated = false
 }
 
 // Get the radius of a circle
@@ -252,6 +261,7 @@ func (this *CircleShape) GetRadius() float32 {
 // Set the number of points of a circle
 func (this *CircleShape) SetPointCount(count uint) {
 	C.sfCircleShape_setPointCount(this.cptr, C.size_t(count))
+	this.boundsUpdated = false
 }
 
 // Get the local bounding rectangle of a circle shape
@@ -261,9 +271,14 @@ func (this *CircleShape) SetPointCount(count uint) {
 // scale, ...) that are applied to the entity.
 // In other words, this function returns the bounds of the
 // entity in the entity's coordinate system.
+// The bounds are computed by SFML once and then kept until the
+// geometry or the outline thickness changes.
 func (this *CircleShape) GetLocalBounds() (rect FloatRect) {
-	rect.fromC(C.sfCircleShape_getLocalBounds(this.cptr))
-	return
+	if !this.boundsUpdated {
+		this.localBounds.fromC(C.sfCircleShape_getLocalBounds(this.cptr))
+		this.boundsUpdated = true
+	}
+	return this.localBounds
 }
 
 // Get the global bounding rectangle of a circle shape
@@ -274,17 +289,57 @@ func (this *CircleShape) GetLocalBounds() (rect FloatRect) {
 // In other words, this function returns the bounds of the
 // sprite in the global 2D world's coordinate system.
 func (this *CircleShape) GetGlobalBounds() (rect FloatRect) {
-	rect.fromC(C.sfCircleShape_getGlobalBounds(this.cptr))
-	return
+	transform := this.getTransform()
+	return transform.TransformRect(this.GetLocalBounds())
 }
 
 //Draws a CircleShape on a render target
+//
+//Other targets receive the geometry SFML would build, through DrawPrimitives.
 func (this *CircleShape) Draw(target RenderTarget, renderStates RenderStates) {
+	this.flush()
 	rs := renderStates.toC()
 	switch target.(type) {
 	case *RenderWindow:
 		C.sfRenderWindow_drawCircleShape(target.(*RenderWindow).cptr, this.cptr, &rs)
 	case *RenderTexture:
 		C.sfRenderTexture_drawCircleShape(target.(*RenderTexture).cptr, this.cptr, &rs)
+	default:
+		points := make([]Vector2f, this.GetPointCount())
+		for i := range points {
+			points[i] = this.GetPoint(uint(i))
+		}
+		fill, outline := shapeGeometry(points, this.textureRect, this.fillColor, this.outlineColor, this.GetOutlineThickness())
+		drawShapeGeometry(target, renderStates, this.getTransform(), this.texture, fill, outline)
+	}
+}
+
+// Send the state changed since the last flush to the C circle shape
+func (this *CircleShape) flush() {
+	if this.dirty == 0 {
+		return
+	}
+
+	if this.dirty&dirtyPosition != 0 {
+		C.sfCircleShape_setPosition(this.cptr, this.position.toC())
+	}
+	if this.dirty&dirtyScale != 0 {
+		C.sfCircleShape_setScale(this.cptr, this.scale.toC())
+	}
+	if this.dirty&dirtyOrigin != 0 {
+		C.sfCircleShape_setOrigin(this.cptr, this.origin.toC())
+	}
+	if this.dirty&dirtyRotation != 0 {
+		C.sfCircleShape_setRotation(this.cptr, C.float(this.rotation))
+	}
+	if this.dirty&dirtyColor != 0 {
+		C.sfCircleShape_setFillColor(this.cptr, this.fillColor.toC())
+	}
+	if this.dirty&dirtyOutlineColor != 0 {
+		C.sfCircleShape_setOutlineColor(this.cptr, this.outlineColor.toC())
+	}
+	if this.dirty&dirtyTextureRect != 0 {
+		C.sfCircleShape_setTextureRect(this.cptr, this.textureRect.toC())
 	}
+	this.dirty = 0
 }
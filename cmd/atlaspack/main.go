@@ -0,0 +1,129 @@
+// Added by Edgaru089
+
+// Command atlaspack packs images into texture atlas pages.
+//
+// It writes the pages as PNG files and a JSON manifest which
+// gosfml2.LoadTextureAtlas turns into textures and regions:
+//
+// 	atlaspack -o assets/sprites.json -padding 2 -extrude 1 -trim sprites/
+//
+// Directories are searched recursively. A region is named after the
+// path of its image relative to the directory given on the command
+// line, without extension and with forward slashes ("enemies/bat");
+// images given directly are named after their base name.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sf "github.com/Edgaru089/gosfml2"
+)
+
+// Extensions of the formats SFML can load
+var imageExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".bmp": true,
+	".tga": true, ".gif": true, ".psd": true, ".hdr": true, ".pic": true,
+}
+
+func main() {
+	var options sf.AtlasOptions
+
+	output := flag.String("o", "atlas.json", "path of the JSON manifest, pages are written next to it")
+	maxSize := flag.Uint("max", 0, "largest width and height of a page, 0 for the maximum texture size")
+	padding := flag.Uint("padding", 0, "transparent pixels between regions")
+	extrude := flag.Uint("extrude", 0, "number of times the border pixels are repeated around each region")
+	flag.BoolVar(&options.Trim, "trim", false, "remove the transparent borders of the images")
+	flag.BoolVar(&options.AllowRotation, "rotate", false, "allow storing images rotated by 90 degrees clockwise")
+	flag.BoolVar(&options.PowerOfTwo, "pot", false, "round the page sizes up to powers of two")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: atlaspack [flags] image-or-directory...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	options.MaxSize, options.Padding, options.Extrude = *maxSize, *padding, *extrude
+	builder := sf.NewAtlasBuilder(options)
+
+	count := 0
+	for _, arg := range flag.Args() {
+		files, err := collectImages(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for name, file := range files {
+			image, err := sf.NewImageFromFile(file)
+			if err != nil {
+				log.Fatalf("%s: %v", file, err)
+			}
+			if err := builder.Add(name, image); err != nil {
+				log.Fatal(err)
+			}
+			count++
+		}
+	}
+
+	atlas, err := builder.Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(*output), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := atlas.Save(*output); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("packed %d images into %d pages:", count, len(atlas.Pages))
+	for _, page := range atlas.Pages {
+		size := page.GetSize()
+		fmt.Printf(" %dx%d", size.X, size.Y)
+	}
+	fmt.Println()
+}
+
+// Map region names to the image files found at path
+func collectImages(path string) (map[string]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	if !info.IsDir() {
+		files[trimExtension(filepath.Base(path))] = path
+		return files, nil
+	}
+
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !imageExtensions[strings.ToLower(filepath.Ext(file))] {
+			return nil
+		}
+
+		relative, err := filepath.Rel(path, file)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(trimExtension(relative))] = file
+		return nil
+	})
+	return files, err
+}
+
+func trimExtension(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path))
+}
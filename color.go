@@ -7,6 +7,14 @@ package gosfml2
 // #include <SFML/Graphics/Color.h>
 import "C"
 
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
 /////////////////////////////////////
 ///		STRUCTS
 /////////////////////////////////////
@@ -36,10 +44,72 @@ func ColorMagenta() Color     { return Color{255, 0, 255, 255} }
 func ColorCyan() Color        { return Color{0, 255, 255, 255} }
 func ColorTransparent() Color { return Color{0, 0, 0, 0} }
 
+// Model converting any color.Color to a Color
+var ColorModel color.Model = color.ModelFunc(colorModel)
+
 /////////////////////////////////////
 ///		FUNCS
 /////////////////////////////////////
 
+// Parse a color written in hexadecimal notation
+//
+// The accepted forms are RGB, RGBA, RRGGBB and RRGGBBAA, with an
+// optional leading '#' or "0x". The alpha defaults to 255.
+//
+// 	hex: Color string, e.g. "#ff8800cc"
+func ColorFromHex(hex string) (Color, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(hex, "#"), "0x")
+
+	switch len(digits) {
+	case 3, 4:
+		// expand the short forms, "f80" is "ff8800"
+		long := make([]byte, 0, 8)
+		for i := 0; i < len(digits); i++ {
+			long = append(long, digits[i], digits[i])
+		}
+		digits = string(long)
+	case 6, 8:
+	default:
+		return Color{}, fmt.Errorf("ColorFromHex: invalid length in %q", hex)
+	}
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("ColorFromHex: invalid digits in %q", hex)
+	}
+
+	return Color{uint8(value >> 24), uint8(value >> 16), uint8(value >> 8), uint8(value)}, nil
+}
+
+// Create an opaque color from hue, saturation and value
+//
+// 	h: Hue in degrees, wrapped to [0, 360)
+// 	s: Saturation in [0, 1]
+// 	v: Value in [0, 1]
+func ColorFromHSV(h, s, v float32) Color {
+	s, v = clamp01(s), clamp01(v)
+	c := v * s
+	r, g, b := hueToRGB(h, c)
+	m := v - c
+	return Color{unitToByte(r + m), unitToByte(g + m), unitToByte(b + m), 255}
+}
+
+// Create an opaque color from hue, saturation and lightness
+//
+// 	h: Hue in degrees, wrapped to [0, 360)
+// 	s: Saturation in [0, 1]
+// 	l: Lightness in [0, 1]
+func ColorFromHSL(h, s, l float32) Color {
+	s, l = clamp01(s), clamp01(l)
+	c := (1 - abs32(2*l-1)) * s
+	r, g, b := hueToRGB(h, c)
+	m := l - c/2
+	return Color{unitToByte(r + m), unitToByte(g + m), unitToByte(b + m), 255}
+}
+
 // Component-wise saturated addition of the two colors
 func (this Color) Add(other Color) (newColor Color) {
 	newColor.fromC(C.sfColor_add(this.toC(), other.toC()))
@@ -52,6 +122,185 @@ func (this Color) Modulate(other Color) (newColor Color) {
 	return
 }
 
+// Return the color in hexadecimal notation
+//
+// The result is "#rrggbb" for opaque colors and "#rrggbbaa" otherwise,
+// and can be parsed back with ColorFromHex.
+func (this Color) Hex() string {
+	if this.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", this.R, this.G, this.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", this.R, this.G, this.B, this.A)
+}
+
+// Return the hue (in degrees), saturation and value of the color
+//
+// The alpha component is ignored.
+func (this Color) HSV() (h, s, v float32) {
+	h, max, min := this.hue()
+	if max > 0 {
+		s = (max - min) / max
+	}
+	return h, s, max
+}
+
+// Return the hue (in degrees), saturation and lightness of the color
+//
+// The alpha component is ignored.
+func (this Color) HSL() (h, s, l float32) {
+	h, max, min := this.hue()
+	l = (max + min) / 2
+	if max != min {
+		s = (max - min) / (1 - abs32(2*l-1))
+	}
+	return h, s, l
+}
+
+// Linear interpolation between two colors, alpha included
+//
+// 	other: Color reached at t = 1
+// 	t:     Interpolation factor, clamped to [0, 1]
+func (this Color) Lerp(other Color, t float32) Color {
+	t = clamp01(t)
+	lerp := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t + 0.5)
+	}
+	return Color{lerp(this.R, other.R), lerp(this.G, other.G), lerp(this.B, other.B), lerp(this.A, other.A)}
+}
+
+// Multiply the red, green and blue components by a factor
+//
+// The result is saturated to 255, the alpha is left unchanged.
+//
+// 	factor: Brightness factor
+func (this Color) Multiply(factor float32) Color {
+	mul := func(c uint8) uint8 {
+		return unitToByte(float32(c) * factor / 255)
+	}
+	return Color{mul(this.R), mul(this.G), mul(this.B), this.A}
+}
+
+// Return the color with its red, green and blue components
+// multiplied by its alpha
+func (this Color) Premultiply() Color {
+	return Color{mulByte(this.R, this.A), mulByte(this.G, this.A), mulByte(this.B, this.A), this.A}
+}
+
+// Undo Premultiply
+//
+// A fully transparent color gives Color{}.
+func (this Color) Unpremultiply() Color {
+	if this.A == 0 {
+		return Color{}
+	}
+	div := func(c uint8) uint8 {
+		if c >= this.A {
+			return 255
+		}
+		return uint8((uint32(c)*255 + uint32(this.A)/2) / uint32(this.A))
+	}
+	return Color{div(this.R), div(this.G), div(this.B), this.A}
+}
+
+// Return the relative luminance of the color, from 0 (black) to 1 (white)
+//
+// The components are treated as sRGB and weighted as in Rec. 709,
+// which is the definition used by the WCAG contrast ratio.
+// The alpha component is ignored.
+func (this Color) Luminance() float32 {
+	linear := func(c uint8) float64 {
+		v := float64(c) / 255
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return float32(0.2126*linear(this.R) + 0.7152*linear(this.G) + 0.0722*linear(this.B))
+}
+
+// Implement color.Color
+//
+// Like every color.Color, the returned values are alpha-premultiplied
+// and in the range [0, 0xffff].
+func (this Color) RGBA() (r, g, b, a uint32) {
+	return color.NRGBA{this.R, this.G, this.B, this.A}.RGBA()
+}
+
+// Return the hue in degrees, and the largest and smallest component in [0, 1]
+func (this Color) hue() (h, max, min float32) {
+	r, g, b := float32(this.R)/255, float32(this.G)/255, float32(this.B)/255
+	max = max32(r, max32(g, b))
+	min = min32(r, min32(g, b))
+
+	delta := max - min
+	switch {
+	case delta == 0:
+		h = 0
+	case max == r:
+		h = 60 * (g - b) / delta
+	case max == g:
+		h = 60 * ((b-r)/delta + 2)
+	default:
+		h = 60 * ((r-g)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func colorModel(c color.Color) color.Color {
+	if c, ok := c.(Color); ok {
+		return c
+	}
+
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return Color{nrgba.R, nrgba.G, nrgba.B, nrgba.A}
+}
+
+// Return the red, green and blue components (without the lightness offset)
+// of a hue with the given chroma
+func hueToRGB(h, chroma float32) (r, g, b float32) {
+	h = float32(math.Mod(float64(h), 360))
+	if h < 0 {
+		h += 360
+	}
+
+	x := chroma * (1 - abs32(float32(math.Mod(float64(h/60), 2))-1))
+	switch {
+	case h < 60:
+		return chroma, x, 0
+	case h < 120:
+		return x, chroma, 0
+	case h < 180:
+		return 0, chroma, x
+	case h < 240:
+		return 0, x, chroma
+	case h < 300:
+		return x, 0, chroma
+	default:
+		return chroma, 0, x
+	}
+}
+
+func clamp01(v float32) float32 {
+	return min32(max32(v, 0), 1)
+}
+
+// Convert a value in [0, 1] to a rounded byte, saturating out of range values
+func unitToByte(v float32) uint8 {
+	return uint8(clamp01(v)*255 + 0.5)
+}
+
+// Multiply two bytes as if they were in [0, 1]
+func mulByte(a, b uint8) uint8 {
+	return uint8((uint32(a)*uint32(b) + 127) / 255)
+}
+
 /////////////////////////////////////
 ///		GO <-> C
 /////////////////////////////////////
@@ -66,3 +315,9 @@ func (this *Color) fromC(color C.sfColor) {
 func (this Color) toC() C.sfColor {
 	return C.sfColor{r: C.sfUint8(this.R), g: C.sfUint8(this.G), b: C.sfUint8(this.B), a: C.sfUint8(this.A)}
 }
+
+/////////////////////////////////////
+///		TEST
+/////////////////////////////////////
+
+var _ color.Color = Color{}
@@ -4,9 +4,31 @@
 
 package gosfml2
 
-// #include <SFML/Window/Context.h>
+/*
+#cgo linux freebsd netbsd openbsd LDFLAGS: -lX11
+
+#include <SFML/Window/Context.h>
+
+#if defined(_WIN32) || defined(__APPLE__)
+static int gosfml_displayAvailable(void) { return 1; }
+#else
+#include <X11/Xlib.h>
+static int gosfml_displayAvailable(void) {
+	Display* display = XOpenDisplay(NULL);
+	if (display == NULL)
+		return 0;
+	XCloseDisplay(display);
+	return 1;
+}
+#endif
+*/
 import "C"
-import "runtime"
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+)
 
 /////////////////////////////////////
 ///		STRUCTS
@@ -40,3 +62,40 @@ func (this *Context) destroy() {
 func (this *Context) SetActive(active bool) {
 	C.sfContext_setActive(this.cptr, goBool2C(active))
 }
+
+/////////////////////////////////////
+///		HEADLESS
+/////////////////////////////////////
+
+// Returned by functions which need an OpenGL context when no display is available
+var ErrNoDisplay = errors.New("No display available to create an OpenGL context (is DISPLAY set?)")
+
+var (
+	displayAvailable bool
+	displayProbeOnce sync.Once
+)
+
+// Tell whether an OpenGL context can be created
+//
+// On Windows and macOS this is always true. On other systems SFML
+// creates its contexts through X11, so the display named by DISPLAY
+// (a real X server, Xvfb or Xwayland) is opened once and closed again
+// to check that it is reachable. The result of the first probe is
+// reused by later calls.
+//
+// Image, SoundBuffer and the other CPU side resources never need
+// a context and can be used even if this returns false.
+func HeadlessAvailable() bool {
+	displayProbeOnce.Do(func() {
+		displayAvailable = C.gosfml_displayAvailable() != 0
+	})
+	return displayAvailable
+}
+
+// Return ErrNoDisplay if no OpenGL context can be created
+func requireDisplay() error {
+	if !HeadlessAvailable() {
+		return ErrNoDisplay
+	}
+	return nil
+}
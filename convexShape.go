@@ -17,6 +17,13 @@ import "runtime"
 type ConvexShape struct {
 	cptr    *C.sfConvexShape
 	texture *Texture //to prevent the GC from deleting the texture
+
+	transformState
+	fillColor     Color
+	outlineColor  Color
+	textureRect   IntRect
+	localBounds   FloatRect
+	boundsUpdated bool
 }
 
 /////////////////////////////////////
@@ -25,7 +32,7 @@ type ConvexShape struct {
 
 func NewConvexShape() (*ConvexShape, error) {
 	if cptr := C.sfConvexShape_create(); cptr != nil {
-		shape := &ConvexShape{cptr, nil}
+		shape := &ConvexShape{cptr: cptr, transformState: newTransformState(), fillColor: ColorWhite(), outlineColor: ColorWhite()}
 		runtime.SetFinalizer(shape, (*ConvexShape).destroy)
 		return shape, nil
 	}
@@ -34,9 +41,12 @@ func NewConvexShape() (*ConvexShape, error) {
 
 //Copy an existing convex shape
 func (this *ConvexShape) Copy() *ConvexShape {
-	shape := &ConvexShape{C.sfConvexShape_copy(this.cptr), this.texture}
-	runtime.SetFinalizer(shape, (*ConvexShape).destroy)
-	return shape
+	this.flush()
+
+	shape := *this
+	shape.cptr = C.sfConvexShape_copy(this.cptr)
+	runtime.SetFinalizer(&shape, (*ConvexShape).destroy)
+	return &shape
 }
 
 func (this *ConvexShape) destroy() {
@@ -49,7 +59,7 @@ func (this *ConvexShape) destroy() {
 // See sfConvexShape_move to apply an offset based on the previous position instead.
 // The default position of a circle Shape object is (0, 0).
 func (this *ConvexShape) SetPosition(pos Vector2f) {
-	C.sfConvexShape_setPosition(this.cptr, pos.toC())
+	this.setPosition(pos)
 }
 
 // Set the local origin of a convex shape
@@ -61,7 +71,7 @@ func (this *ConvexShape) SetPosition(pos Vector2f) {
 // transformations (position, scale, rotation).
 // The default origin of a circle Shape object is (0, 0).
 func (this *ConvexShape) SetScale(scale Vector2f) {
-	C.sfConvexShape_setScale(this.cptr, scale.toC())
+	this.setScale(scale)
 }
 
 // Set the local origin of a convex shape
@@ -73,7 +83,7 @@ func (this *ConvexShape) SetScale(scale Vector2f) {
 // transformations (position, scale, rotation).
 // The default origin of a circle Shape object is (0, 0).
 func (this *ConvexShape) SetOrigin(orig Vector2f) {
-	C.sfConvexShape_setOrigin(this.cptr, orig.toC())
+	this.setOrigin(orig)
 }
 
 // Set the scale factors of a convex shape
@@ -82,30 +92,27 @@ func (this *ConvexShape) SetOrigin(orig Vector2f) {
 // See sfConvexShape_scale to add a factor based on the previous scale instead.
 // The default scale of a circle Shape object is (1, 1).
 func (this *ConvexShape) SetRotation(rot float32) {
-	C.sfConvexShape_setRotation(this.cptr, C.float(rot))
+	this.setRotation(rot)
 }
 
 // Get the orientation of a convex shape
 func (this *ConvexShape) GetRotation() float32 {
-	return float32(C.sfConvexShape_getRotation(this.cptr))
+	return this.rotation
 }
 
 // Get the position of a convex shape
 func (this *ConvexShape) GetPosition() (position Vector2f) {
-	position.fromC(C.sfConvexShape_getPosition(this.cptr))
-	return
+	return this.position
 }
 
 // Get the current scale of a convex shape
 func (this *ConvexShape) GetScale() (scale Vector2f) {
-	scale.fromC(C.sfConvexShape_getScale(this.cptr))
-	return
+	return this.scale
 }
 
 // Get the local origin of a convex shape
 func (this *ConvexShape) GetOrigin() (origin Vector2f) {
-	origin.fromC(C.sfConvexShape_getOrigin(this.cptr))
-	return
+	return this.origin
 }
 
 // Move a convex shape by a given offset
@@ -113,7 +120,7 @@ func (this *ConvexShape) GetOrigin() (origin Vector2f) {
 // This function adds to the current position of the object,
 // unlike ConvexShape.SetPosition which overwrites it.
 func (this *ConvexShape) Move(offset Vector2f) {
-	C.sfConvexShape_move(this.cptr, offset.toC())
+	this.move(offset)
 }
 
 // Scale a convex shape
@@ -121,7 +128,7 @@ func (this *ConvexShape) Move(offset Vector2f) {
 // This function multiplies the current scale of the object,
 // unlike ConvexShape.SetScale which overwrites it.
 func (this *ConvexShape) Scale(factor Vector2f) {
-	C.sfConvexShape_scale(this.cptr, factor.toC())
+	this.scaleBy(factor)
 }
 
 // Rotate a convex shape
@@ -129,7 +136,7 @@ func (this *ConvexShape) Scale(factor Vector2f) {
 // This function adds to the current rotation of the object,
 // unlike ConvexShape.SetRotation which overwrites it.
 func (this *ConvexShape) Rotate(angle float32) {
-	C.sfConvexShape_rotate(this.cptr, C.float(angle))
+	this.rotate(angle)
 }
 
 // Change the source texture of a convex shape
@@ -139,8 +146,10 @@ func (this *ConvexShape) Rotate(angle float32) {
 // the shape is automatically adjusted to the size of the new
 // texture. If it is false, the texture rect is left unchanged.
 func (this *ConvexShape) SetTexture(texture *Texture, resetRect bool) {
+	this.flush()
 	C.sfConvexShape_setTexture(this.cptr, texture.toCPtr(), goBool2C(resetRect))
 	this.texture = texture
+	this.textureRect.fromC(C.sfConvexShape_getTextureRect(this.cptr))
 }
 
 // Set the sub-rectangle of the texture that a convex shape will display
@@ -149,7 +158,8 @@ func (this *ConvexShape) SetTexture(texture *Texture, resetRect bool) {
 // the whole texture, but rather a part of it.
 // By default, the texture rect covers the entire texture.
 func (this *ConvexShape) SetTextureRect(rect IntRect) {
-	C.sfConvexShape_setTextureRect(this.cptr, rect.toC())
+	this.textureRect = rect
+	this.dirty |= dirtyTextureRect
 }
 
 // Set the fill color of a convex shape
@@ -161,7 +171,8 @@ func (this *ConvexShape) SetTextureRect(rect IntRect) {
 // the shape transparent, and have the outline alone.
 // By default, the shape's fill color is opaque white.
 func (this *ConvexShape) SetFillColor(color Color) {
-	C.sfConvexShape_setFillColor(this.cptr, color.toC())
+	this.fillColor = color
+	this.dirty |= dirtyColor
 }
 
 // Set the outline color of a convex shape
@@ -169,7 +180,8 @@ func (this *ConvexShape) SetFillColor(color Color) {
 // You can use sfTransparent to disable the outline.
 // By default, the shape's outline color is opaque white.
 func (this *ConvexShape) SetOutlineColor(color Color) {
-	C.sfConvexShape_setOutlineColor(this.cptr, color.toC())
+	this.outlineColor = color
+	this.dirty |= dirtyOutlineColor
 }
 
 // Set the thickness of a convex shape's outline
@@ -179,6 +191,7 @@ func (this *ConvexShape) SetOutlineColor(color Color) {
 // By default, the outline thickness is 0.
 func (this *ConvexShape) SetOutlineThickness(thickness float32) {
 	C.sfConvexShape_setOutlineThickness(this.cptr, C.float(thickness))
+	this.boundsUpdated = false
 }
 
 // Get the source texture of a convex shape
@@ -190,32 +203,27 @@ func (this *ConvexShape) GetTexture() *Texture {
 
 // Get the sub-rectangle of the texture displayed by a convex shape
 func (this *ConvexShape) GetTextureRect() (rect IntRect) {
-	rect.fromC(C.sfConvexShape_getTextureRect(this.cptr))
-	return
+	return this.textureRect
 }
 
 // Get the combined transform of a convex shape
 func (this *ConvexShape) GetTransform() (transform Transform) {
-	transform.fromC(C.sfConvexShape_getTransform(this.cptr))
-	return
+	return this.getTransform()
 }
 
 // Get the inverse of the combined transform of a convex shape
 func (this *ConvexShape) GetInverseTransform() (transform Transform) {
-	transform.fromC(C.sfConvexShape_getInverseTransform(this.cptr))
-	return
+	return this.getInverseTransform()
 }
 
 // Get the fill color of a convex shape
 func (this *ConvexShape) GetFillColor() (color Color) {
-	color.fromC(C.sfConvexShape_getFillColor(this.cptr))
-	return
+	return this.fillColor
 }
 
 // Get the outline color of a convex shape
 func (this *ConvexShape) GetOutlineColor() (color Color) {
-	color.fromC(C.sfConvexShape_getOutlineColor(this.cptr))
-	return
+	return this.outlineColor
 }
 
 // Get the outline thickness of a convex shape
@@ -241,6 +249,7 @@ func (this *ConvexShape) GetPoint(index uint) (point Vector2f) {
 // count must be greater than 2 to define a valid shape.
 func (this *ConvexShape) SetPointCount(count uint) {
 	C.sfConvexShape_setPointCount(this.cptr, C.size_t(count))
+	this.boundsUpdated = false
 }
 
 // Set the position of a point in a convex shape
@@ -252,6 +261,7 @@ func (this *ConvexShape) SetPointCount(count uint) {
 // of the valid range.
 func (this *ConvexShape) SetPoint(index uint, point Vector2f) {
 	C.sfConvexShape_setPoint(this.cptr, C.size_t(index), point.toC())
+	this.boundsUpdated = false
 }
 
 // Get the local bounding rectangle of a convex shape
@@ -261,9 +271,14 @@ func (this *ConvexShape) SetPoint(index uint, point Vector2f) {
 // scale, ...) that are applied to the entity.
 // In other words, this function returns the bounds of the
 // entity in the entity's coordinate system.
+// The bounds are computed by SFML once and then kept until the
+// geometry or the outline thickness changes.
 func (this *ConvexShape) GetLocalBounds() (rect FloatRect) {
-	rect.fromC(C.sfConvexShape_getLocalBounds(this.cptr))
-	return
+	if !this.boundsUpdated {
+		this.localBounds.fromC(C.sfConvexShape_getLocalBounds(this.cptr))
+		this.boundsUpdated = true
+	}
+	return this.localBounds
 }
 
 // Get the global bounding rectangle of a convex shape
@@ -274,17 +289,57 @@ func (this *ConvexShape) GetLocalBounds() (rect FloatRect) {
 // In other words, this function returns the bounds of the
 // sprite in the global 2D world's coordinate system.
 func (this *ConvexShape) GetGlobalBounds() (rect FloatRect) {
-	rect.fromC(C.sfConvexShape_getGlobalBounds(this.cptr))
-	return
+	transform := this.getTransform()
+	return transform.TransformRect(this.GetLocalBounds())
 }
 
 // Draws a convex Shape on a render target
+//
+// Other targets receive the geometry SFML would build, through DrawPrimitives.
 func (this *ConvexShape) Draw(target RenderTarget, renderStates RenderStates) {
+	this.flush()
 	rs := renderStates.toC()
 	switch target.(type) {
 	case *RenderWindow:
 		C.sfRenderWindow_drawConvexShape(target.(*RenderWindow).cptr, this.cptr, &rs)
 	case *RenderTexture:
 		C.sfRenderTexture_drawConvexShape(target.(*RenderTexture).cptr, this.cptr, &rs)
+	default:
+		points := make([]Vector2f, this.GetPointCount())
+		for i := range points {
+			points[i] = this.GetPoint(uint(i))
+		}
+		fill, outline := shapeGeometry(points, this.textureRect, this.fillColor, this.outlineColor, this.GetOutlineThickness())
+		drawShapeGeometry(target, renderStates, this.getTransform(), this.texture, fill, outline)
+	}
+}
+
+// Send the state changed since the last flush to the C convex shape
+func (this *ConvexShape) flush() {
+	if this.dirty == 0 {
+		return
+	}
+
+	if this.dirty&dirtyPosition != 0 {
+		C.sfConvexShape_setPosition(this.cptr, this.position.toC())
+	}
+	if this.dirty&dirtyScale != 0 {
+		C.sfConvexShape_setScale(this.cptr, this.scale.toC())
+	}
+	if this.dirty&dirtyOrigin != 0 {
+		C.sfConvexShape_setOrigin(this.cptr, this.origin.toC())
+	}
+	if this.dirty&dirtyRotation != 0 {
+		C.sfConvexShape_setRotation(this.cptr, C.float(this.rotation))
+	}
+	if this.dirty&dirtyColor != 0 {
+		C.sfConvexShape_setFillColor(this.cptr, this.fillColor.toC())
+	}
+	if this.dirty&dirtyOutlineColor != 0 {
+		C.sfConvexShape_setOutlineColor(this.cptr, this.outlineColor.toC())
+	}
+	if this.dirty&dirtyTextureRect != 0 {
+		C.sfConvexShape_setTextureRect(this.cptr, this.textureRect.toC())
 	}
+	this.dirty = 0
 }
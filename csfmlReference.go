@@ -0,0 +1,182 @@
+// Added by Edgaru089
+
+package gosfml2
+
+/*
+#include <SFML/Graphics/Transform.h>
+#include <SFML/Graphics/Sprite.h>
+#include <SFML/Graphics/Text.h>
+#include <SFML/Graphics/RectangleShape.h>
+#include <SFML/Graphics/CircleShape.h>
+#include <SFML/Graphics/ConvexShape.h>
+*/
+import "C"
+
+// The functions of this file call the CSFML implementation of what
+// the package computes in Go. They are only used by the tests, to
+// check the Go code against CSFML, and by the benchmarks.
+
+/////////////////////////////////////
+///		TRANSFORM
+/////////////////////////////////////
+
+func csfmlTransformCombine(transform, other Transform) Transform {
+	ct, co := transform.toC(), other.toC()
+	C.sfTransform_combine(&ct, &co)
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformTranslate(transform Transform, x, y float32) Transform {
+	ct := transform.toC()
+	C.sfTransform_translate(&ct, C.float(x), C.float(y))
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformRotate(transform Transform, angle float32) Transform {
+	ct := transform.toC()
+	C.sfTransform_rotate(&ct, C.float(angle))
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformRotateWithCenter(transform Transform, angle, centerX, centerY float32) Transform {
+	ct := transform.toC()
+	C.sfTransform_rotateWithCenter(&ct, C.float(angle), C.float(centerX), C.float(centerY))
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformScale(transform Transform, scaleX, scaleY float32) Transform {
+	ct := transform.toC()
+	C.sfTransform_scale(&ct, C.float(scaleX), C.float(scaleY))
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformScaleWithCenter(transform Transform, scaleX, scaleY, centerX, centerY float32) Transform {
+	ct := transform.toC()
+	C.sfTransform_scaleWithCenter(&ct, C.float(scaleX), C.float(scaleY), C.float(centerX), C.float(centerY))
+	transform.fromC(ct)
+	return transform
+}
+
+func csfmlTransformGetInverse(transform Transform) (inverse Transform) {
+	ct := transform.toC()
+	inverse.fromC(C.sfTransform_getInverse(&ct))
+	return
+}
+
+func csfmlTransformPoint(transform Transform, point Vector2f) (result Vector2f) {
+	ct := transform.toC()
+	result.fromC(C.sfTransform_transformPoint(&ct, point.toC()))
+	return
+}
+
+func csfmlTransformRect(transform Transform, rect FloatRect) (result FloatRect) {
+	ct := transform.toC()
+	result.fromC(C.sfTransform_transformRect(&ct, rect.toC()))
+	return
+}
+
+/////////////////////////////////////
+///		DRAWABLES
+/////////////////////////////////////
+
+// State of a drawable as its C object reports it
+//
+// Color is the fill color of the shapes. OutlineColor is only set for
+// shapes and TextureRect for sprites and shapes.
+type csfmlDrawableState struct {
+	Position         Vector2f
+	Scale            Vector2f
+	Origin           Vector2f
+	Rotation         float32
+	Transform        Transform
+	InverseTransform Transform
+	GlobalBounds     FloatRect
+	Color            Color
+	OutlineColor     Color
+	TextureRect      IntRect
+}
+
+func csfmlSpriteState(sprite *Sprite) (state csfmlDrawableState) {
+	state.Position.fromC(C.sfSprite_getPosition(sprite.cptr))
+	state.Scale.fromC(C.sfSprite_getScale(sprite.cptr))
+	state.Origin.fromC(C.sfSprite_getOrigin(sprite.cptr))
+	state.Rotation = float32(C.sfSprite_getRotation(sprite.cptr))
+	state.Transform.fromC(C.sfSprite_getTransform(sprite.cptr))
+	state.InverseTransform.fromC(C.sfSprite_getInverseTransform(sprite.cptr))
+	state.GlobalBounds.fromC(C.sfSprite_getGlobalBounds(sprite.cptr))
+	state.Color.fromC(C.sfSprite_getColor(sprite.cptr))
+	state.TextureRect.fromC(C.sfSprite_getTextureRect(sprite.cptr))
+	return
+}
+
+func csfmlTextState(text *Text) (state csfmlDrawableState) {
+	state.Position.fromC(C.sfText_getPosition(text.cptr))
+	state.Scale.fromC(C.sfText_getScale(text.cptr))
+	state.Origin.fromC(C.sfText_getOrigin(text.cptr))
+	state.Rotation = float32(C.sfText_getRotation(text.cptr))
+	state.Transform.fromC(C.sfText_getTransform(text.cptr))
+	state.InverseTransform.fromC(C.sfText_getInverseTransform(text.cptr))
+	state.GlobalBounds.fromC(C.sfText_getGlobalBounds(text.cptr))
+	state.Color.fromC(C.sfText_getColor(text.cptr))
+	return
+}
+
+func csfmlRectangleShapeState(shape *RectangleShape) (state csfmlDrawableState) {
+	state.Position.fromC(C.sfRectangleShape_getPosition(shape.cptr))
+	state.Scale.fromC(C.sfRectangleShape_getScale(shape.cptr))
+	state.Origin.fromC(C.sfRectangleShape_getOrigin(shape.cptr))
+	state.Rotation = float32(C.sfRectangleShape_getRotation(shape.cptr))
+	state.Transform.fromC(C.sfRectangleShape_getTransform(shape.cptr))
+	state.InverseTransform.fromC(C.sfRectangleShape_getInverseTransform(shape.cptr))
+	state.GlobalBounds.fromC(C.sfRectangleShape_getGlobalBounds(shape.cptr))
+	state.Color.fromC(C.sfRectangleShape_getFillColor(shape.cptr))
+	state.OutlineColor.fromC(C.sfRectangleShape_getOutlineColor(shape.cptr))
+	state.TextureRect.fromC(C.sfRectangleShape_getTextureRect(shape.cptr))
+	return
+}
+
+func csfmlCircleShapeState(shape *CircleShape) (state csfmlDrawableState) {
+	state.Position.fromC(C.sfCircleShape_getPosition(shape.cptr))
+	state.Scale.fromC(C.sfCircleShape_getScale(shape.cptr))
+	state.Origin.fromC(C.sfCircleShape_getOrigin(shape.cptr))
+	state.Rotation = float32(C.sfCircleShape_getRotation(shape.cptr))
+	state.Transform.fromC(C.sfCircleShape_getTransform(shape.cptr))
+	state.InverseTransform.fromC(C.sfCircleShape_getInverseTransform(shape.cptr))
+	state.GlobalBounds.fromC(C.sfCircleShape_getGlobalBounds(shape.cptr))
+	state.Color.fromC(C.sfCircleShape_getFillColor(shape.cptr))
+	state.OutlineColor.fromC(C.sfCircleShape_getOutlineColor(shape.cptr))
+	state.TextureRect.fromC(C.sfCircleShape_getTextureRect(shape.cptr))
+	return
+}
+
+func csfmlConvexShapeState(shape *ConvexShape) (state csfmlDrawableState) {
+	state.Position.fromC(C.sfConvexShape_getPosition(shape.cptr))
+	state.Scale.fromC(C.sfConvexShape_getScale(shape.cptr))
+	state.Origin.fromC(C.sfConvexShape_getOrigin(shape.cptr))
+	state.Rotation = float32(C.sfConvexShape_getRotation(shape.cptr))
+	state.Transform.fromC(C.sfConvexShape_getTransform(shape.cptr))
+	state.InverseTransform.fromC(C.sfConvexShape_getInverseTransform(shape.cptr))
+	state.GlobalBounds.fromC(C.sfConvexShape_getGlobalBounds(shape.cptr))
+	state.Color.fromC(C.sfConvexShape_getFillColor(shape.cptr))
+	state.OutlineColor.fromC(C.sfConvexShape_getOutlineColor(shape.cptr))
+	state.TextureRect.fromC(C.sfConvexShape_getTextureRect(shape.cptr))
+	return
+}
+
+// Move and turn a sprite, then read its bounds, with one cgo call for each
+func csfmlSpriteUpdate(sprite *Sprite, position Vector2f, rotation float32) (bounds FloatRect) {
+	C.sfSprite_setPosition(sprite.cptr, position.toC())
+	C.sfSprite_setRotation(sprite.cptr, C.float(rotation))
+	bounds.fromC(C.sfSprite_getGlobalBounds(sprite.cptr))
+	return
+}
+
+func csfmlSpriteGetTransform(sprite *Sprite) (transform Transform) {
+	transform.fromC(C.sfSprite_getTransform(sprite.cptr))
+	return
+}
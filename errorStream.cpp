@@ -0,0 +1,32 @@
+// Added by Edgaru089
+
+#include <SFML/System/Err.hpp>
+
+#include <cstdlib>
+#include <cstring>
+#include <sstream>
+#include <string>
+
+namespace {
+	std::stringstream captureBuffer;
+	std::streambuf* previousBuffer = 0;
+}
+
+// Redirect sf::err() into captureBuffer
+extern "C" void gosfml_beginErrorCapture(void) {
+	captureBuffer.str("");
+	captureBuffer.clear();
+	previousBuffer = sf::err().rdbuf(captureBuffer.rdbuf());
+}
+
+// Restore sf::err() and return everything written to it since
+// gosfml_beginErrorCapture, the caller owns the returned string
+extern "C" char* gosfml_endErrorCapture(void) {
+	sf::err().rdbuf(previousBuffer);
+	previousBuffer = 0;
+
+	std::string str = captureBuffer.str();
+	char* cstr = static_cast<char*>(std::malloc(str.size() + 1));
+	std::memcpy(cstr, str.c_str(), str.size() + 1);
+	return cstr;
+}
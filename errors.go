@@ -0,0 +1,117 @@
+// Added by Edgaru089
+
+package gosfml2
+
+/*
+#include <stdlib.h>
+
+void gosfml_beginErrorCapture(void);
+char* gosfml_endErrorCapture(void);
+*/
+import "C"
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// LoadError is returned when SFML fails to create or load a resource.
+//
+// Message holds what SFML wrote to its error stream (sf::err())
+// during the failing call.
+type LoadError struct {
+	Resource string // Kind of resource, e.g. "image", "texture" or "font"
+	Path     string // File the resource was loaded from, empty if loaded from memory
+	Message  string // Error output of SFML
+}
+
+// ShaderCompileError is returned when a shader fails to compile or link.
+type ShaderCompileError struct {
+	Stage string // "vertex", "geometry", "fragment" or "link"
+	Log   string // Compiler/linker log as reported by the driver
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+func (this *LoadError) Error() string {
+	from := "memory"
+	if len(this.Path) > 0 {
+		from = "\"" + this.Path + "\""
+	}
+	return "Failed to load " + this.Resource + " from " + from + ": " + this.Message
+}
+
+func (this *ShaderCompileError) Error() string {
+	if this.Stage == "link" {
+		return "Failed to link shader: " + this.Log
+	}
+	return "Failed to compile " + this.Stage + " shader: " + this.Log
+}
+
+/////////////////////////////////////
+///		ERROR STREAM CAPTURE
+/////////////////////////////////////
+
+// sf::err() is a single global stream, so only one call can capture it at a time
+var errorCaptureMutex sync.Mutex
+
+// Start capturing everything SFML writes to sf::err()
+//
+// Every call must be matched by a call to endErrorCapture.
+func beginErrorCapture() {
+	errorCaptureMutex.Lock()
+	C.gosfml_beginErrorCapture()
+}
+
+// Stop capturing sf::err() and return what was written to it
+func endErrorCapture() string {
+	cstr := C.gosfml_endErrorCapture()
+	errorCaptureMutex.Unlock()
+
+	defer C.free(unsafe.Pointer(cstr))
+	return strings.TrimSpace(C.GoString(cstr))
+}
+
+// Build a LoadError from the captured SFML output
+func newLoadError(resource, path, message string) error {
+	if len(message) == 0 {
+		message = "no details reported by SFML"
+	}
+	return &LoadError{Resource: resource, Path: path, Message: message}
+}
+
+// Build an error from the captured SFML output of a failed operation
+// which does not load anything (saving to a file, for example)
+func newSfmlError(message string) error {
+	if len(message) == 0 {
+		return genericError
+	}
+	return errors.New(message)
+}
+
+// Turn the captured output of a failed shader creation into an error
+//
+// Compilation and link failures become a ShaderCompileError,
+// anything else (missing file, no shader support) a LoadError.
+func newShaderError(path, message string) error {
+	for _, stage := range []string{"vertex", "geometry", "fragment"} {
+		prefix := "Failed to compile " + stage + " shader:"
+		if strings.HasPrefix(message, prefix) {
+			return &ShaderCompileError{Stage: stage, Log: strings.TrimSpace(message[len(prefix):])}
+		}
+	}
+
+	if prefix := "Failed to link shader:"; strings.HasPrefix(message, prefix) {
+		return &ShaderCompileError{Stage: "link", Log: strings.TrimSpace(message[len(prefix):])}
+	}
+
+	return newLoadError("shader", path, message)
+}
@@ -17,6 +17,15 @@ package gosfml2
 // sfJoystickConnectEvent* getJoystickConnectEvent(sfEvent* ev) { return &ev->joystickConnect; }
 import "C"
 
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
 /////////////////////////////////////
 ///		CONSTS
 /////////////////////////////////////
@@ -44,6 +53,27 @@ const (
 	EventTypeJoystickDisconnected   EventType = C.sfEvtJoystickDisconnected
 )
 
+var eventTypeNames = map[EventType]string{
+	EventTypeClosed:                 "Closed",
+	EventTypeResized:                "Resized",
+	EventTypeLostFocus:              "LostFocus",
+	EventTypeGainedFocus:            "GainedFocus",
+	EventTypeTextEntered:            "TextEntered",
+	EventTypeKeyPressed:             "KeyPressed",
+	EventTypeKeyReleased:            "KeyReleased",
+	EventTypeMouseWheelMoved:        "MouseWheelMoved",
+	EventTypeMouseButtonPressed:     "MouseButtonPressed",
+	EventTypeMouseButtonReleased:    "MouseButtonReleased",
+	EventTypeMouseMoved:             "MouseMoved",
+	EventTypeMouseEntered:           "MouseEntered",
+	EventTypeMouseLeft:              "MouseLeft",
+	EventTypeJoystickButtonPressed:  "JoystickButtonPressed",
+	EventTypeJoystickButtonReleased: "JoystickButtonReleased",
+	EventTypeJoystickMoved:          "JoystickMoved",
+	EventTypeJoystickConnected:      "JoystickConnected",
+	EventTypeJoystickDisconnected:   "JoystickDisconnected",
+}
+
 /////////////////////////////////////
 ///		INTERFACES
 /////////////////////////////////////
@@ -52,6 +82,37 @@ type Event interface {
 	Type() EventType
 }
 
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Return the name of the event type, e.g. "KeyPressed"
+func (this EventType) String() string {
+	if name, ok := eventTypeNames[this]; ok {
+		return name
+	}
+	return "EventType(" + strconv.Itoa(int(this)) + ")"
+}
+
+// Encode the event type as its name
+func (this EventType) MarshalText() ([]byte, error) {
+	if _, ok := eventTypeNames[this]; !ok {
+		return nil, errors.New("EventType.MarshalText: unknown event type " + strconv.Itoa(int(this)))
+	}
+	return []byte(this.String()), nil
+}
+
+// Decode an event type from its name
+func (this *EventType) UnmarshalText(text []byte) error {
+	for typ, name := range eventTypeNames {
+		if name == string(text) {
+			*this = typ
+			return nil
+		}
+	}
+	return errors.New("EventType.UnmarshalText: unknown event type \"" + string(text) + "\"")
+}
+
 ///////////////////////////////////////////////////////////////
 //	EmptyEvents
 
@@ -312,3 +373,267 @@ func handleEvent(cEvent *C.sfEvent) (ev Event) {
 	}
 	return
 }
+
+///////////////////////////////////////////////////////////////
+//channel based event delivery used by Window & RenderWindow
+
+// Interval at which WaitEventTimeout polls an empty event queue again
+const eventPollInterval = 2 * time.Millisecond
+
+// Longest time a pump waits for a frame or a pushed event before polling anyway
+const eventIdleInterval = time.Second / 60
+
+// Poll events with poll and deliver them on the returned channel
+//
+// poll and isOpen go through Call, on the main thread while Run is active.
+// The pump sleeps until the window displays a frame or an event is
+// pushed to hooks, and polls at least every eventIdleInterval so that
+// windows which only redraw on input still get their events.
+// The channel is closed when ctx is done or isOpen reports the window closed.
+func pumpEvents(ctx context.Context, hooks *eventHooks, poll func() Event, isOpen func() bool) <-chan Event {
+	events := make(chan Event, 16)
+	wake := hooks.subscribe()
+
+	go func() {
+		defer close(events)
+		defer hooks.unsubscribe(wake)
+
+		timer := time.NewTimer(eventIdleInterval)
+		defer timer.Stop()
+
+		for {
+			var pending []Event
+			var open bool
+
+			Call(func() {
+				for ev := poll(); ev != nil; ev = poll() {
+					pending = append(pending, ev)
+				}
+				open = isOpen()
+			})
+
+			for _, ev := range pending {
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if !open {
+				return
+			}
+
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(eventIdleInterval)
+
+			select {
+			case <-wake:
+			case <-timer.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// Poll events with poll until one arrives or timeout elapses
+func waitEventTimeout(poll func() Event, timeout time.Duration) Event {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		if ev := poll(); ev != nil {
+			return ev
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		if remaining > eventPollInterval {
+			remaining = eventPollInterval
+		}
+		time.Sleep(remaining)
+	}
+}
+
+///////////////////////////////////////////////////////////////
+//event hooks shared by Window & RenderWindow
+
+type eventHooks struct {
+	//set from any goroutine, read by the pollers and Display
+	dispatcher *EventDispatcher
+	recorder   *EventRecorder
+	replayer   *EventReplayer
+	mutex      sync.Mutex
+
+	injected      []Event
+	injectedMutex sync.Mutex
+
+	//signalled by push and endFrame, one per event pump
+	wakers     []chan struct{}
+	wakerMutex sync.Mutex
+}
+
+// Attach a dispatcher, nil to detach it
+func (this *eventHooks) setDispatcher(dispatcher *EventDispatcher) {
+	this.mutex.Lock()
+	this.dispatcher = dispatcher
+	this.mutex.Unlock()
+}
+
+// Attach a recorder, nil to detach it
+func (this *eventHooks) setRecorder(recorder *EventRecorder) {
+	this.mutex.Lock()
+	this.recorder = recorder
+	this.mutex.Unlock()
+}
+
+// Attach a replayer, nil to detach it
+func (this *eventHooks) setReplayer(replayer *EventReplayer) {
+	this.mutex.Lock()
+	this.replayer = replayer
+	this.mutex.Unlock()
+}
+
+// Get the attached dispatcher, recorder and replayer
+func (this *eventHooks) attached() (*EventDispatcher, *EventRecorder, *EventReplayer) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	return this.dispatcher, this.recorder, this.replayer
+}
+
+// Queue an event to be returned ahead of the OS events
+func (this *eventHooks) push(ev Event) {
+	//events are passed by value everywhere else, a pointer would
+	//not match the type switches of the dispatcher and InputState
+	if ev == nil || reflect.ValueOf(ev).Kind() == reflect.Ptr {
+		return
+	}
+
+	this.injectedMutex.Lock()
+	this.injected = append(this.injected, ev)
+	this.injectedMutex.Unlock()
+
+	this.wake()
+}
+
+// Register a channel signalled whenever new events may be available
+func (this *eventHooks) subscribe() chan struct{} {
+	waker := make(chan struct{}, 1)
+
+	this.wakerMutex.Lock()
+	this.wakers = append(this.wakers, waker)
+	this.wakerMutex.Unlock()
+
+	return waker
+}
+
+// Remove a channel registered with subscribe
+func (this *eventHooks) unsubscribe(waker chan struct{}) {
+	this.wakerMutex.Lock()
+	defer this.wakerMutex.Unlock()
+
+	for i, w := range this.wakers {
+		if w == waker {
+			this.wakers = append(this.wakers[:i], this.wakers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Signal every subscribed channel without blocking
+func (this *eventHooks) wake() {
+	this.wakerMutex.Lock()
+	defer this.wakerMutex.Unlock()
+
+	for _, waker := range this.wakers {
+		select {
+		case waker <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Pop the oldest injected event, nil if there is none
+func (this *eventHooks) popInjected() Event {
+	this.injectedMutex.Lock()
+	defer this.injectedMutex.Unlock()
+
+	if len(this.injected) == 0 {
+		return nil
+	}
+
+	ev := this.injected[0]
+	this.injected[0] = nil
+	this.injected = this.injected[1:]
+	return ev
+}
+
+// Get the next event from the injected events, poll (or the replayer), record and dispatch it
+func (this *eventHooks) poll(poll func() Event) Event {
+	dispatcher, recorder, replayer := this.attached()
+
+	return dispatchEvents(dispatcher, func() Event {
+		if ev := this.popInjected(); ev != nil {
+			return recordEvent(recorder, ev)
+		}
+		if replayer != nil {
+			discardEvents(poll)
+			return recordEvent(recorder, replayer.Next())
+		}
+		return recordEvent(recorder, poll())
+	})
+}
+
+// Wait for the next event from the injected events, wait (or the replayer), record and dispatch it
+func (this *eventHooks) wait(wait, poll func() Event) Event {
+	dispatcher, recorder, replayer := this.attached()
+
+	return dispatchEvents(dispatcher, func() Event {
+		if ev := this.popInjected(); ev != nil {
+			return recordEvent(recorder, ev)
+		}
+		if replayer != nil {
+			discardEvents(poll)
+			return recordEvent(recorder, replayer.Wait())
+		}
+		return recordEvent(recorder, wait())
+	})
+}
+
+// Called by Display once per frame
+func (this *eventHooks) endFrame() {
+	_, recorder, replayer := this.attached()
+
+	if recorder != nil {
+		recorder.NextFrame()
+	}
+	if replayer != nil {
+		replayer.NextFrame()
+	}
+
+	this.wake()
+}
+
+// Pass an event to recorder, if any, and return it
+func recordEvent(recorder *EventRecorder, ev Event) Event {
+	if ev != nil && recorder != nil {
+		recorder.Record(ev)
+	}
+	return ev
+}
+
+// Empty the OS event queue, so that the window stays responsive
+func discardEvents(poll func() Event) {
+	for poll() != nil {
+	}
+}
@@ -0,0 +1,295 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"sort"
+	"sync"
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Identifies a handler registered on an EventDispatcher
+type EventHandlerId uint64
+
+// EventDispatcher calls typed handlers for the events it is given
+//
+// Handlers run in order of decreasing priority; handlers with the
+// same priority run in registration order. A handler returns true
+// to consume the event, which stops the propagation to the handlers
+// after it.
+//
+// A dispatcher attached to a window with SetEventDispatcher sees
+// every event before PollEvent/WaitEvent return it, and consumed
+// events are not returned at all. This allows a UI layer registered
+// with a high priority to swallow clicks before gameplay sees them.
+type EventDispatcher struct {
+	mutex    sync.Mutex
+	handlers []eventHandler
+	nextId   EventHandlerId
+}
+
+type eventHandler struct {
+	id       EventHandlerId
+	anyType  bool
+	typ      EventType
+	priority int
+	handle   func(Event) bool
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a new event dispatcher without handlers
+func NewEventDispatcher() *EventDispatcher {
+	return &EventDispatcher{}
+}
+
+// Register a handler called for all events of the given type
+//
+// 	typ:      Type of the events to handle
+// 	priority: Handlers with higher priority are called first
+// 	handler:  Function called with the event, returns true to consume it
+func (this *EventDispatcher) On(typ EventType, priority int, handler func(Event) bool) EventHandlerId {
+	return this.add(eventHandler{typ: typ, priority: priority, handle: handler})
+}
+
+// Register a handler called for every event
+//
+// 	priority: Handlers with higher priority are called first
+// 	handler:  Function called with the event, returns true to consume it
+func (this *EventDispatcher) OnAny(priority int, handler func(Event) bool) EventHandlerId {
+	return this.add(eventHandler{anyType: true, priority: priority, handle: handler})
+}
+
+// Remove a handler from the dispatcher
+//
+// returns false if no handler with this id is registered.
+func (this *EventDispatcher) Remove(id EventHandlerId) bool {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	for i, handler := range this.handlers {
+		if handler.id == id {
+			this.handlers = append(this.handlers[:i:i], this.handlers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Remove all the handlers from the dispatcher
+func (this *EventDispatcher) Clear() {
+	this.mutex.Lock()
+	this.handlers = nil
+	this.mutex.Unlock()
+}
+
+// Pass an event to the registered handlers
+//
+// Handlers may register or remove handlers while being called,
+// the changes apply from the next event on.
+//
+// returns true if a handler consumed the event.
+func (this *EventDispatcher) Dispatch(ev Event) bool {
+	if ev == nil {
+		return false
+	}
+
+	this.mutex.Lock()
+	handlers := this.handlers
+	this.mutex.Unlock()
+
+	typ := ev.Type()
+	for _, handler := range handlers {
+		if (handler.anyType || handler.typ == typ) && handler.handle(ev) {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *EventDispatcher) add(handler eventHandler) EventHandlerId {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	this.nextId++
+	handler.id = this.nextId
+
+	//insert after all the handlers with a higher or equal priority
+	i := sort.Search(len(this.handlers), func(i int) bool {
+		return this.handlers[i].priority < handler.priority
+	})
+
+	//build a new slice, Dispatch may still be iterating the old one
+	handlers := make([]eventHandler, 0, len(this.handlers)+1)
+	handlers = append(handlers, this.handlers[:i]...)
+	handlers = append(handlers, handler)
+	this.handlers = append(handlers, this.handlers[i:]...)
+
+	return handler.id
+}
+
+// Pull events from next until one is not consumed by dispatcher
+func dispatchEvents(dispatcher *EventDispatcher, next func() Event) Event {
+	for {
+		ev := next()
+		if ev == nil || dispatcher == nil || !dispatcher.Dispatch(ev) {
+			return ev
+		}
+	}
+}
+
+/////////////////////////////////////
+///		TYPED HANDLERS
+/////////////////////////////////////
+
+// The typed handlers only see events of their exact value type, other
+// events with the same EventType (pointers, for example) are passed on.
+
+// Register a handler for EventClosed
+func (this *EventDispatcher) OnClosed(priority int, handler func(EventClosed) bool) EventHandlerId {
+	return this.On(EventTypeClosed, priority, func(ev Event) bool {
+		e, ok := ev.(EventClosed)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventResized
+func (this *EventDispatcher) OnResized(priority int, handler func(EventResized) bool) EventHandlerId {
+	return this.On(EventTypeResized, priority, func(ev Event) bool {
+		e, ok := ev.(EventResized)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventLostFocus
+func (this *EventDispatcher) OnLostFocus(priority int, handler func(EventLostFocus) bool) EventHandlerId {
+	return this.On(EventTypeLostFocus, priority, func(ev Event) bool {
+		e, ok := ev.(EventLostFocus)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventGainedFocus
+func (this *EventDispatcher) OnGainedFocus(priority int, handler func(EventGainedFocus) bool) EventHandlerId {
+	return this.On(EventTypeGainedFocus, priority, func(ev Event) bool {
+		e, ok := ev.(EventGainedFocus)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventTextEntered
+func (this *EventDispatcher) OnTextEntered(priority int, handler func(EventTextEntered) bool) EventHandlerId {
+	return this.On(EventTypeTextEntered, priority, func(ev Event) bool {
+		e, ok := ev.(EventTextEntered)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventKeyPressed
+func (this *EventDispatcher) OnKeyPressed(priority int, handler func(EventKeyPressed) bool) EventHandlerId {
+	return this.On(EventTypeKeyPressed, priority, func(ev Event) bool {
+		e, ok := ev.(EventKeyPressed)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventKeyReleased
+func (this *EventDispatcher) OnKeyReleased(priority int, handler func(EventKeyReleased) bool) EventHandlerId {
+	return this.On(EventTypeKeyReleased, priority, func(ev Event) bool {
+		e, ok := ev.(EventKeyReleased)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseWheelMoved
+func (this *EventDispatcher) OnMouseWheelMoved(priority int, handler func(EventMouseWheelMoved) bool) EventHandlerId {
+	return this.On(EventTypeMouseWheelMoved, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseWheelMoved)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseButtonPressed
+func (this *EventDispatcher) OnMouseButtonPressed(priority int, handler func(EventMouseButtonPressed) bool) EventHandlerId {
+	return this.On(EventTypeMouseButtonPressed, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseButtonPressed)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseButtonReleased
+func (this *EventDispatcher) OnMouseButtonReleased(priority int, handler func(EventMouseButtonReleased) bool) EventHandlerId {
+	return this.On(EventTypeMouseButtonReleased, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseButtonReleased)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseMoved
+func (this *EventDispatcher) OnMouseMoved(priority int, handler func(EventMouseMoved) bool) EventHandlerId {
+	return this.On(EventTypeMouseMoved, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseMoved)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseEntered
+func (this *EventDispatcher) OnMouseEntered(priority int, handler func(EventMouseEntered) bool) EventHandlerId {
+	return this.On(EventTypeMouseEntered, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseEntered)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventMouseLeft
+func (this *EventDispatcher) OnMouseLeft(priority int, handler func(EventMouseLeft) bool) EventHandlerId {
+	return this.On(EventTypeMouseLeft, priority, func(ev Event) bool {
+		e, ok := ev.(EventMouseLeft)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventJoystickButtonPressed
+func (this *EventDispatcher) OnJoystickButtonPressed(priority int, handler func(EventJoystickButtonPressed) bool) EventHandlerId {
+	return this.On(EventTypeJoystickButtonPressed, priority, func(ev Event) bool {
+		e, ok := ev.(EventJoystickButtonPressed)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventJoystickButtonReleased
+func (this *EventDispatcher) OnJoystickButtonReleased(priority int, handler func(EventJoystickButtonReleased) bool) EventHandlerId {
+	return this.On(EventTypeJoystickButtonReleased, priority, func(ev Event) bool {
+		e, ok := ev.(EventJoystickButtonReleased)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventJoystickMoved
+func (this *EventDispatcher) OnJoystickMoved(priority int, handler func(EventJoystickMoved) bool) EventHandlerId {
+	return this.On(EventTypeJoystickMoved, priority, func(ev Event) bool {
+		e, ok := ev.(EventJoystickMoved)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventJoystickConnected
+func (this *EventDispatcher) OnJoystickConnected(priority int, handler func(EventJoystickConnected) bool) EventHandlerId {
+	return this.On(EventTypeJoystickConnected, priority, func(ev Event) bool {
+		e, ok := ev.(EventJoystickConnected)
+		return ok && handler(e)
+	})
+}
+
+// Register a handler for EventJoystickDisconnected
+func (this *EventDispatcher) OnJoystickDisconnected(priority int, handler func(EventJoystickDisconnected) bool) EventHandlerId {
+	return this.On(EventTypeJoystickDisconnected, priority, func(ev Event) bool {
+		e, ok := ev.(EventJoystickDisconnected)
+		return ok && handler(e)
+	})
+}
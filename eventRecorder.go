@@ -0,0 +1,335 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+// Format identifier and version written at the start of recordings
+const (
+	eventRecordingFormat  = "gosfml2-events"
+	eventRecordingVersion = 1
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// EventRecord is a single recorded event
+type EventRecord struct {
+	Frame uint64        // Frame the event was received in, starting at 0
+	Time  time.Duration // Time since the recording started
+	Event Event
+}
+
+// EventRecorder writes events to a stream as JSON lines
+//
+// The first line is a header identifying the format and its
+// version, each following line holds one event:
+//
+// 	{"format":"gosfml2-events","version":1}
+// 	{"frame":0,"time":1520000,"type":"KeyPressed","event":{"Code":57,"Alt":0,"Control":0,"Shift":0,"System":0}}
+//
+// Attach it to a window with SetEventRecorder, or call Record
+// and NextFrame directly.
+type EventRecorder struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	start   time.Time
+	frame   uint64
+	err     error
+}
+
+// EventReplayer plays back a recording made by EventRecorder
+//
+// Attach it to a window with SetEventReplayer, or call Next
+// and NextFrame directly.
+type EventReplayer struct {
+	mutex   sync.Mutex
+	records []EventRecord
+	next    int
+	frame   uint64
+}
+
+type eventRecordingHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+type eventRecordLine struct {
+	Frame uint64          `json:"frame"`
+	Time  int64           `json:"time"` // nanoseconds
+	Type  EventType       `json:"type"`
+	Event json.RawMessage `json:"event,omitempty"`
+}
+
+type eventLine struct {
+	Type  EventType       `json:"type"`
+	Event json.RawMessage `json:"event,omitempty"`
+}
+
+/////////////////////////////////////
+///		VARS
+/////////////////////////////////////
+
+// Go type of the event of each EventType, used for decoding
+var eventGoTypes = map[EventType]reflect.Type{
+	EventTypeClosed:                 reflect.TypeOf(EventClosed{}),
+	EventTypeResized:                reflect.TypeOf(EventResized{}),
+	EventTypeLostFocus:              reflect.TypeOf(EventLostFocus{}),
+	EventTypeGainedFocus:            reflect.TypeOf(EventGainedFocus{}),
+	EventTypeTextEntered:            reflect.TypeOf(EventTextEntered{}),
+	EventTypeKeyPressed:             reflect.TypeOf(EventKeyPressed{}),
+	EventTypeKeyReleased:            reflect.TypeOf(EventKeyReleased{}),
+	EventTypeMouseWheelMoved:        reflect.TypeOf(EventMouseWheelMoved{}),
+	EventTypeMouseButtonPressed:     reflect.TypeOf(EventMouseButtonPressed{}),
+	EventTypeMouseButtonReleased:    reflect.TypeOf(EventMouseButtonReleased{}),
+	EventTypeMouseMoved:             reflect.TypeOf(EventMouseMoved{}),
+	EventTypeMouseEntered:           reflect.TypeOf(EventMouseEntered{}),
+	EventTypeMouseLeft:              reflect.TypeOf(EventMouseLeft{}),
+	EventTypeJoystickButtonPressed:  reflect.TypeOf(EventJoystickButtonPressed{}),
+	EventTypeJoystickButtonReleased: reflect.TypeOf(EventJoystickButtonReleased{}),
+	EventTypeJoystickMoved:          reflect.TypeOf(EventJoystickMoved{}),
+	EventTypeJoystickConnected:      reflect.TypeOf(EventJoystickConnected{}),
+	EventTypeJoystickDisconnected:   reflect.TypeOf(EventJoystickDisconnected{}),
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Encode an event as JSON
+//
+// The result holds the event type by name and the event fields:
+// 	{"type":"MouseMoved","event":{"X":10,"Y":20}}
+func MarshalEvent(ev Event) ([]byte, error) {
+	if ev == nil {
+		return nil, errors.New("MarshalEvent: nil event")
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(eventLine{Type: ev.Type(), Event: data})
+}
+
+// Decode an event encoded by MarshalEvent
+func UnmarshalEvent(data []byte) (Event, error) {
+	var line eventLine
+	if err := json.Unmarshal(data, &line); err != nil {
+		return nil, err
+	}
+	return decodeEvent(line.Type, line.Event)
+}
+
+// Build the event of the given type from its JSON fields
+func decodeEvent(typ EventType, data json.RawMessage) (Event, error) {
+	goType, ok := eventGoTypes[typ]
+	if !ok {
+		return nil, errors.New("decodeEvent: unknown event type " + typ.String())
+	}
+
+	ev := reflect.New(goType)
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, ev.Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return ev.Elem().Interface().(Event), nil
+}
+
+/////////////////////////////////////
+// EventRecorder
+
+// Create a recorder writing to w
+//
+// The header is written immediately, the clock starts now.
+func NewEventRecorder(w io.Writer) (*EventRecorder, error) {
+	recorder := &EventRecorder{encoder: json.NewEncoder(w), start: time.Now()}
+
+	if err := recorder.encoder.Encode(eventRecordingHeader{Format: eventRecordingFormat, Version: eventRecordingVersion}); err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// Write an event in the current frame
+//
+// A nil event, as returned by PollEvent when the queue is empty,
+// is ignored. After a write error, nothing more is written and
+// the error is returned by every call and by Err.
+func (this *EventRecorder) Record(ev Event) error {
+	if ev == nil {
+		return nil
+	}
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.err != nil {
+		return this.err
+	}
+
+	data, err := json.Marshal(ev)
+	if err == nil {
+		err = this.encoder.Encode(eventRecordLine{
+			Frame: this.frame,
+			Time:  int64(time.Since(this.start)),
+			Type:  ev.Type(),
+			Event: data,
+		})
+	}
+
+	this.err = err
+	return err
+}
+
+// Start a new frame
+func (this *EventRecorder) NextFrame() {
+	this.mutex.Lock()
+	this.frame++
+	this.mutex.Unlock()
+}
+
+// Get the current frame number
+func (this *EventRecorder) Frame() uint64 {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return this.frame
+}
+
+// Get the first error met while writing, if any
+func (this *EventRecorder) Err() error {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return this.err
+}
+
+/////////////////////////////////////
+// EventReplayer
+
+// Create a replayer from a recording read from r
+//
+// The whole recording is read and validated immediately.
+func NewEventReplayer(r io.Reader) (*EventReplayer, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("NewEventReplayer: empty recording")
+	}
+
+	var header eventRecordingHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, err
+	}
+	if header.Format != eventRecordingFormat {
+		return nil, errors.New("NewEventReplayer: not an event recording")
+	}
+	if header.Version != eventRecordingVersion {
+		return nil, errors.New("NewEventReplayer: unsupported recording version")
+	}
+
+	replayer := &EventReplayer{}
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var line eventRecordLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return nil, err
+		}
+
+		ev, err := decodeEvent(line.Type, line.Event)
+		if err != nil {
+			return nil, err
+		}
+
+		replayer.records = append(replayer.records, EventRecord{Frame: line.Frame, Time: time.Duration(line.Time), Event: ev})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return replayer, nil
+}
+
+// Create a replayer from records in memory
+func NewEventReplayerFromRecords(records []EventRecord) *EventReplayer {
+	return &EventReplayer{records: append([]EventRecord(nil), records...)}
+}
+
+// Get the next event of the current frame
+//
+// returns nil when all the events of the current frame were returned.
+func (this *EventReplayer) Next() Event {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.next < len(this.records) && this.records[this.next].Frame <= this.frame {
+		ev := this.records[this.next].Event
+		this.next++
+		return ev
+	}
+	return nil
+}
+
+// Get the next event, skipping to its frame if needed
+//
+// returns nil when the recording is over.
+func (this *EventReplayer) Wait() Event {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.next < len(this.records) {
+		record := this.records[this.next]
+		if record.Frame > this.frame {
+			this.frame = record.Frame
+		}
+		this.next++
+		return record.Event
+	}
+	return nil
+}
+
+// Advance to the next frame
+func (this *EventReplayer) NextFrame() {
+	this.mutex.Lock()
+	this.frame++
+	this.mutex.Unlock()
+}
+
+// Get the current frame number
+func (this *EventReplayer) Frame() uint64 {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return this.frame
+}
+
+// Tell whether all the recorded events were replayed
+func (this *EventReplayer) Done() bool {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return this.next >= len(this.records)
+}
+
+// Get all the records of the recording
+func (this *EventReplayer) Records() []EventRecord {
+	return append([]EventRecord(nil), this.records...)
+}
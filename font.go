@@ -38,13 +38,17 @@ func NewFontFromFile(filename string) (*Font, error) {
 	cFilename := C.CString(filename)
 	defer C.free(unsafe.Pointer(cFilename))
 
-	if cptr := C.sfFont_createFromFile(cFilename); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfFont_createFromFile(cFilename)
+	message := endErrorCapture()
+
+	if cptr != nil {
 		font := &Font{cptr}
 		runtime.SetFinalizer(font, (*Font).destroy)
 		return font, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("font", filename, message)
 }
 
 // Font constructor
@@ -57,12 +61,16 @@ func NewFontFromMemory(data []byte) (*Font, error) {
 		return nil, errors.New("NewFontFromMemory: len(data)==0")
 	}
 
-	if cptr := C.sfFont_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data))); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfFont_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		font := &Font{cptr}
 		runtime.SetFinalizer(font, (*Font).destroy)
 		return font, nil
 	}
-	return nil, genericError
+	return nil, newLoadError("font", "", message)
 }
 
 func (this *Font) Copy() *Font {
@@ -72,6 +80,13 @@ func (this *Font) Copy() *Font {
 }
 
 func (this *Font) destroy() {
+	//don't create the shared context just to destroy a font, it would
+	//crash on headless machines
+	if !globalCtxCreated() {
+		C.sfFont_destroy(this.cptr)
+		return
+	}
+
 	globalCtxSetActive(true)
 	C.sfFont_destroy(this.cptr)
 	globalCtxSetActive(false)
@@ -0,0 +1,245 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+const (
+	// Default cap on the time simulated per frame
+	DefaultMaxFrameTime = 250 * time.Millisecond
+
+	// Time slept per frame while the game is paused
+	gamePausedSleep = 10 * time.Millisecond
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Game runs a fixed timestep game loop on a render window
+//
+// Update is called at a fixed rate with a constant time step,
+// while rendering happens once per frame, as fast as allowed by
+// FramerateLimit and VSync. Render receives how far, in range
+// [0 .. 1), the current time is between the last update and the
+// next one, to interpolate the state it draws.
+//
+// If a frame takes longer than MaxFrameTime (after a breakpoint or
+// a stall, for example), the extra time is dropped instead of being
+// simulated, so the game slows down rather than freezing in an
+// ever growing number of updates.
+type Game struct {
+	Window *RenderWindow
+
+	UpdateRate       uint          // Updates per second
+	MaxFrameTime     time.Duration // Longest time simulated per frame (DefaultMaxFrameTime if 0)
+	FramerateLimit   uint          // Passed to Window.SetFramerateLimit when Run starts (0 for no limit)
+	VSync            bool          // Passed to Window.SetVSyncEnabled when Run starts
+	PauseOnFocusLoss bool          // Stop updating while the window does not have the focus (true by NewGame)
+	ClearColor       Color         // Color the window is cleared with before Render
+
+	// Called for every event, the window is closed on EventClosed if nil
+	HandleEvent func(ev Event)
+	// Called UpdateRate times per second with the fixed time step
+	Update func(dt time.Duration)
+	// Called once per frame, between Clear and Display
+	Render func(target RenderTarget, alpha float64)
+
+	paused      int32 // set by SetPaused, accessed atomically
+	stopped     int32 // set by Stop, accessed atomically
+	focusPaused int32 // set by the loop while the window is unfocused, accessed atomically
+	stats       FrameStats
+	sampler     frameSampler
+}
+
+// FrameStats holds timing statistics of a Game
+//
+// The rates and frame times are measured over the last full second.
+type FrameStats struct {
+	Frames  uint64 // Frames rendered since Run started
+	Updates uint64 // Updates run since Run started
+
+	FPS              float64       // Frames per second
+	UPS              float64       // Updates per second
+	AverageFrameTime time.Duration // Average time between two frames
+	MinFrameTime     time.Duration // Shortest time between two frames
+	MaxFrameTime     time.Duration // Longest time between two frames
+
+	DroppedTime time.Duration // Time discarded by the MaxFrameTime clamp since Run started
+}
+
+type frameSampler struct {
+	start    time.Time
+	frames   uint64
+	updates  uint64
+	total    time.Duration
+	min, max time.Duration
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a game running on window with the given update rate
+func NewGame(window *RenderWindow, updateRate uint) *Game {
+	return &Game{
+		Window:           window,
+		UpdateRate:       updateRate,
+		MaxFrameTime:     DefaultMaxFrameTime,
+		PauseOnFocusLoss: true,
+		ClearColor:       ColorBlack(),
+	}
+}
+
+// Run the game loop until the window is closed or Stop is called
+func (this *Game) Run() {
+	if this.UpdateRate == 0 {
+		panic("Game.Run: UpdateRate must not be 0")
+	}
+
+	step := time.Second / time.Duration(this.UpdateRate)
+	maxFrameTime := this.MaxFrameTime
+	if maxFrameTime <= 0 {
+		maxFrameTime = DefaultMaxFrameTime
+	}
+
+	this.Window.SetFramerateLimit(this.FramerateLimit)
+	this.Window.SetVSyncEnabled(this.VSync)
+
+	atomic.StoreInt32(&this.stopped, 0)
+	atomic.StoreInt32(&this.focusPaused, 0)
+	this.stats = FrameStats{}
+	this.sampler = frameSampler{start: time.Now()}
+
+	var accumulator time.Duration
+	previous := time.Now()
+
+	for atomic.LoadInt32(&this.stopped) == 0 && this.Window.IsOpen() {
+		this.processEvents()
+
+		now := time.Now()
+		frameTime := now.Sub(previous)
+		previous = now
+
+		if frameTime > maxFrameTime {
+			this.stats.DroppedTime += frameTime - maxFrameTime
+			frameTime = maxFrameTime
+		}
+
+		paused := this.IsPaused()
+		if !paused {
+			accumulator += frameTime
+			for accumulator >= step {
+				if this.Update != nil {
+					this.Update(step)
+				}
+				accumulator -= step
+				this.stats.Updates++
+				this.sampler.updates++
+			}
+		}
+
+		this.Window.Clear(this.ClearColor)
+		if this.Render != nil {
+			this.Render(this.Window, float64(accumulator)/float64(step))
+		}
+		this.Window.Display()
+
+		this.stats.Frames++
+		this.sampler.add(frameTime, now, &this.stats)
+
+		if paused {
+			time.Sleep(gamePausedSleep)
+		}
+	}
+}
+
+// Make Run return after the current frame
+//
+// Stop is safe to call from any goroutine.
+func (this *Game) Stop() {
+	atomic.StoreInt32(&this.stopped, 1)
+}
+
+// Pause or resume the updates, rendering continues while paused
+//
+// The manual pause is independent from the pause caused by
+// PauseOnFocusLoss: regaining the focus doesn't resume a game
+// paused with SetPaused(true).
+// SetPaused is safe to call from any goroutine.
+func (this *Game) SetPaused(paused bool) {
+	var value int32
+	if paused {
+		value = 1
+	}
+	atomic.StoreInt32(&this.paused, value)
+}
+
+// Tell whether the updates are paused, manually or by a focus loss
+//
+// IsPaused is safe to call from any goroutine.
+func (this *Game) IsPaused() bool {
+	return atomic.LoadInt32(&this.paused) != 0 || atomic.LoadInt32(&this.focusPaused) != 0
+}
+
+// Get the timing statistics of the game
+//
+// Stats must be called from the goroutine running Run (from Update,
+// Render or HandleEvent, for example).
+func (this *Game) Stats() FrameStats {
+	return this.stats
+}
+
+func (this *Game) processEvents() {
+	for ev := this.Window.PollEvent(); ev != nil; ev = this.Window.PollEvent() {
+		switch ev.(type) {
+		case EventLostFocus:
+			if this.PauseOnFocusLoss {
+				atomic.StoreInt32(&this.focusPaused, 1)
+			}
+		case EventGainedFocus:
+			atomic.StoreInt32(&this.focusPaused, 0)
+		case EventClosed:
+			if this.HandleEvent == nil {
+				this.Window.Close()
+			}
+		}
+
+		if this.HandleEvent != nil {
+			this.HandleEvent(ev)
+		}
+	}
+}
+
+// Account a frame and publish the statistics once a second
+func (this *frameSampler) add(frameTime time.Duration, now time.Time, stats *FrameStats) {
+	if this.frames == 0 || frameTime < this.min {
+		this.min = frameTime
+	}
+	if frameTime > this.max {
+		this.max = frameTime
+	}
+	this.frames++
+	this.total += frameTime
+
+	elapsed := now.Sub(this.start)
+	if elapsed < time.Second {
+		return
+	}
+
+	seconds := elapsed.Seconds()
+	stats.FPS = float64(this.frames) / seconds
+	stats.UPS = float64(this.updates) / seconds
+	stats.AverageFrameTime = this.total / time.Duration(this.frames)
+	stats.MinFrameTime = this.min
+	stats.MaxFrameTime = this.max
+
+	*this = frameSampler{start: now}
+}
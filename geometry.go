@@ -0,0 +1,124 @@
+// Added by Edgaru089
+
+package gosfml2
+
+// The native drawables are drawn by CSFML on RenderWindow and RenderTexture.
+// Other targets only receive DrawPrimitives calls, so the geometry SFML
+// would build internally is rebuilt here in Go, with the same layout.
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Build the vertices of a sprite as a triangle strip
+func spriteGeometry(textureRect IntRect, color Color) [4]Vertex {
+	width, height := float32(absInt(textureRect.Width)), float32(absInt(textureRect.Height))
+
+	left, top := float32(textureRect.Left), float32(textureRect.Top)
+	right, bottom := left+float32(textureRect.Width), top+float32(textureRect.Height)
+
+	return [4]Vertex{
+		{Position: Vector2f{0, 0}, Color: color, TexCoords: Vector2f{left, top}},
+		{Position: Vector2f{0, height}, Color: color, TexCoords: Vector2f{left, bottom}},
+		{Position: Vector2f{width, 0}, Color: color, TexCoords: Vector2f{right, top}},
+		{Position: Vector2f{width, height}, Color: color, TexCoords: Vector2f{right, bottom}},
+	}
+}
+
+// Build the fill of a shape as a triangle fan and its outline as a triangle strip
+//
+// The outline is nil if thickness is 0, both are nil for less than 3 points.
+func shapeGeometry(points []Vector2f, textureRect IntRect, fillColor, outlineColor Color, thickness float32) (fill, outline []Vertex) {
+	count := len(points)
+	if count < 3 {
+		return nil, nil
+	}
+
+	// the fan starts at the center of the shape and closes on the first point
+	fill = make([]Vertex, count+2)
+	for i, point := range points {
+		fill[i+1].Position = point
+	}
+	fill[count+1].Position = points[0]
+
+	minX, minY, maxX, maxY := points[0].X, points[0].Y, points[0].X, points[0].Y
+	for _, point := range points[1:] {
+		minX, minY = min32(minX, point.X), min32(minY, point.Y)
+		maxX, maxY = max32(maxX, point.X), max32(maxY, point.Y)
+	}
+	inside := FloatRect{minX, minY, maxX - minX, maxY - minY}
+	fill[0].Position = Vector2f{inside.Left + inside.Width/2, inside.Top + inside.Height/2}
+
+	for i := range fill {
+		var ratioX, ratioY float32
+		if inside.Width > 0 {
+			ratioX = (fill[i].Position.X - inside.Left) / inside.Width
+		}
+		if inside.Height > 0 {
+			ratioY = (fill[i].Position.Y - inside.Top) / inside.Height
+		}
+		fill[i].Color = fillColor
+		fill[i].TexCoords = Vector2f{
+			X: float32(textureRect.Left) + float32(textureRect.Width)*ratioX,
+			Y: float32(textureRect.Top) + float32(textureRect.Height)*ratioY,
+		}
+	}
+
+	if thickness == 0 {
+		return fill, nil
+	}
+
+	center := fill[0].Position
+	outline = make([]Vertex, (count+1)*2)
+	for i := 0; i < count; i++ {
+		p0 := fill[count].Position
+		if i > 0 {
+			p0 = fill[i].Position
+		}
+		p1, p2 := fill[i+1].Position, fill[i+2].Position
+
+		// normals of the two edges, pointing outwards
+		n1, n2 := edgeNormal(p0, p1), edgeNormal(p1, p2)
+		if n1.Dot(center.Minus(p1)) > 0 {
+			n1 = n1.Neg()
+		}
+		if n2.Dot(center.Minus(p1)) > 0 {
+			n2 = n2.Neg()
+		}
+
+		factor := 1 + n1.Dot(n2)
+		normal := n1.Plus(n2).Scale(1 / factor)
+
+		outline[i*2] = Vertex{Position: p1, Color: outlineColor}
+		outline[i*2+1] = Vertex{Position: p1.Plus(normal.Scale(thickness)), Color: outlineColor}
+	}
+	outline[count*2], outline[count*2+1] = outline[0], outline[1]
+
+	return fill, outline
+}
+
+// Draw a shape on a target implemented in Go, as sf::Shape::draw does
+func drawShapeGeometry(target RenderTarget, renderStates RenderStates, transform Transform, texture *Texture, fill, outline []Vertex) {
+	if fill == nil {
+		return
+	}
+
+	renderStates.Transform.Combine(&transform)
+
+	renderStates.Texture = texture
+	target.DrawPrimitives(fill, PrimitiveTrianglesFan, renderStates)
+
+	if outline != nil {
+		renderStates.Texture = nil
+		target.DrawPrimitives(outline, PrimitiveTrianglesStrip, renderStates)
+	}
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Unit normal of the edge from p1 to p2
+func edgeNormal(p1, p2 Vector2f) Vector2f {
+	return Vector2f{X: p1.Y - p2.Y, Y: p2.X - p1.X}.Normalize()
+}
@@ -22,10 +22,12 @@ import (
 )
 
 var (
-	globalCtx   = NewContext()
-	globalMutex sync.Mutex
+	//created on first use, so that importing the package does not need a display
+	globalCtx     *Context
+	globalCtxOnce sync.Once
+	globalMutex   sync.Mutex
 
-	//As SFML does not provide useful error codes, we just return a generic error message
+	//Returned when a call fails without SFML reporting anything on its error stream
 	genericError = errors.New("Error: See stderr for more details")
 )
 
@@ -60,11 +62,22 @@ func strToRunes(str string) []rune {
 	return append([]rune(str), rune(0))
 }
 
+// Tell whether the shared context has been created yet
+func globalCtxCreated() bool {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+	return globalCtx != nil
+}
+
 func globalCtxSetActive(active bool) {
 	if active {
 		globalMutex.Lock()
 	}
 
+	globalCtxOnce.Do(func() {
+		globalCtx = NewContext()
+	})
+
 	globalCtx.SetActive(active)
 
 	if !active {
@@ -6,11 +6,13 @@ package gosfml2
 
 // #include <SFML/Graphics/Image.h>
 // #include <stdlib.h>
-// sfUint8 sfImage_getPixelsPtrValue(const sfImage* image, int index) { return sfImage_getPixelsPtr(image)[index]; }
 import "C"
 
 import (
 	"errors"
+	"image"
+	"image/color"
+	"image/draw"
 	"runtime"
 	"unsafe"
 )
@@ -44,13 +46,17 @@ func NewImageFromFile(file string) (*Image, error) {
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if cptr := C.sfImage_createFromFile(cFile); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfImage_createFromFile(cFile)
+	message := endErrorCapture()
+
+	if cptr != nil {
 		image := &Image{cptr}
 		runtime.SetFinalizer(image, (*Image).destroy)
 		return image, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("image", file, message)
 }
 
 // Create an image
@@ -60,13 +66,17 @@ func NewImageFromFile(file string) (*Image, error) {
 // 	width:  Width of the image
 // 	height: Height of the image
 func NewImage(width, height uint) (*Image, error) {
-	if cptr := C.sfImage_create(C.uint(width), C.uint(height)); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfImage_create(C.uint(width), C.uint(height))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		image := &Image{cptr}
 		runtime.SetFinalizer(image, (*Image).destroy)
 		return image, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("image", "", message)
 }
 
 // Create an image and fill it with a unique color
@@ -75,13 +85,17 @@ func NewImage(width, height uint) (*Image, error) {
 // 	height: Height of the image
 // 	color:  Fill color
 func NewImageFromColor(width, height uint, color Color) (*Image, error) {
-	if cptr := C.sfImage_createFromColor(C.uint(width), C.uint(height), color.toC()); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfImage_createFromColor(C.uint(width), C.uint(height), color.toC())
+	message := endErrorCapture()
+
+	if cptr != nil {
 		image := &Image{cptr}
 		runtime.SetFinalizer(image, (*Image).destroy)
 		return image, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("image", "", message)
 }
 
 // Create an image from an array of pixels
@@ -99,13 +113,17 @@ func NewImageFromPixels(width, height uint, data []byte) (*Image, error) {
 		return nil, errors.New("NewImageFromPixels: len(data)==0")
 	}
 
-	if cptr := C.sfImage_createFromPixels(C.uint(width), C.uint(height), (*C.sfUint8)(&data[0])); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfImage_createFromPixels(C.uint(width), C.uint(height), (*C.sfUint8)(&data[0]))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		image := &Image{cptr}
 		runtime.SetFinalizer(image, (*Image).destroy)
 		return image, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("image", "", message)
 }
 
 // Create an image from a file in memory
@@ -120,12 +138,65 @@ func NewImageFromMemory(data []byte) (*Image, error) {
 		return nil, errors.New("NewImageFromMemory: len(data)==0")
 	}
 
-	if cptr := C.sfImage_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data))); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfImage_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		image := &Image{cptr}
 		runtime.SetFinalizer(image, (*Image).destroy)
 		return image, nil
 	}
-	return nil, genericError
+	return nil, newLoadError("image", "", message)
+}
+
+// Create an image from any Go image
+//
+// The pixels are converted to non premultiplied RGBA, the
+// top-left corner of img.Bounds() becomes (0, 0).
+// *image.NRGBA and *image.RGBA are copied row by row, other
+// image types go through their color model pixel by pixel.
+//
+// 	img: Go image to copy
+func NewImageFromGoImage(img image.Image) (*Image, error) {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil, errors.New("NewImageFromGoImage: bounds are empty")
+	}
+
+	width, height := bounds.Dx(), bounds.Dy()
+	data := make([]byte, width*height*4)
+
+	switch src := img.(type) {
+	case *image.NRGBA:
+		for y := 0; y < height; y++ {
+			offset := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(data[y*width*4:(y+1)*width*4], src.Pix[offset:offset+width*4])
+		}
+	case *image.RGBA:
+		for y := 0; y < height; y++ {
+			offset := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			row := data[y*width*4 : (y+1)*width*4]
+			copy(row, src.Pix[offset:offset+width*4])
+			for i := 0; i < len(row); i += 4 {
+				if a := row[i+3]; a != 255 {
+					pixel := Color{row[i], row[i+1], row[i+2], a}.Unpremultiply()
+					row[i], row[i+1], row[i+2] = pixel.R, pixel.G, pixel.B
+				}
+			}
+		}
+	default:
+		i := 0
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				pixel := color.NRGBAModel.Convert(src.At(x, y)).(color.NRGBA)
+				data[i], data[i+1], data[i+2], data[i+3] = pixel.R, pixel.G, pixel.B, pixel.A
+				i += 4
+			}
+		}
+	}
+
+	return NewImageFromPixels(uint(width), uint(height), data)
 }
 
 // Copy an existing image
@@ -152,8 +223,12 @@ func (this *Image) SaveToFile(file string) error {
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if !sfBool2Go(C.sfImage_saveToFile(this.cptr, cFile)) {
-		return genericError
+	beginErrorCapture()
+	success := sfBool2Go(C.sfImage_saveToFile(this.cptr, cFile))
+	message := endErrorCapture()
+
+	if !success {
+		return newSfmlError(message)
 	}
 
 	return nil
@@ -228,11 +303,53 @@ func (this *Image) GetPixel(x, y uint) (color Color) {
 //
 // The length of the slice is width * height * 4 (RGBA).
 func (this *Image) GetPixelData() []byte {
-	data := make([]byte, this.GetSize().X*this.GetSize().Y*4)
-	for i := 0; i < len(data); i++ {
-		data[i] = byte(C.sfImage_getPixelsPtrValue(this.cptr, C.int(i)))
+	size := this.GetSize()
+	if size.X == 0 || size.Y == 0 {
+		return []byte{}
 	}
-	return data
+	return C.GoBytes(unsafe.Pointer(C.sfImage_getPixelsPtr(this.cptr)), C.int(size.X*size.Y*4))
+}
+
+// Return the bounds of an image, implements image.Image
+//
+// The bounds always start at (0, 0).
+func (this *Image) Bounds() image.Rectangle {
+	size := this.GetSize()
+	return image.Rect(0, 0, int(size.X), int(size.Y))
+}
+
+// Return ColorModel, implements image.Image
+func (this *Image) ColorModel() color.Model {
+	return ColorModel
+}
+
+// Return the color of a pixel, implements image.Image
+//
+// Unlike GetPixel, coordinates outside of the image are
+// allowed and give Color{}.
+//
+// 	x: X coordinate of the pixel
+// 	y: Y coordinate of the pixel
+func (this *Image) At(x, y int) color.Color {
+	if !(image.Point{x, y}).In(this.Bounds()) {
+		return Color{}
+	}
+	return this.GetPixel(uint(x), uint(y))
+}
+
+// Change the color of a pixel, implements draw.Image
+//
+// c is converted with ColorModel. Unlike SetPixel, coordinates
+// outside of the image are allowed and ignored.
+//
+// 	x: X coordinate of the pixel
+// 	y: Y coordinate of the pixel
+// 	c: New color of the pixel
+func (this *Image) Set(x, y int, c color.Color) {
+	if !(image.Point{x, y}).In(this.Bounds()) {
+		return
+	}
+	this.SetPixel(uint(x), uint(y), ColorModel.Convert(c).(Color))
 }
 
 // Flip an image horizontally (left <-> right)
@@ -255,3 +372,9 @@ func (this *Image) toCPtr() *C.sfImage {
 	}
 	return nil
 }
+
+/////////////////////////////////////
+///		TEST
+/////////////////////////////////////
+
+var _ draw.Image = (*Image)(nil)
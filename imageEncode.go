@@ -0,0 +1,264 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+type ImageFormat int
+
+const (
+	ImageFormatPNG  ImageFormat = iota ///< Portable Network Graphics
+	ImageFormatJPEG                    ///< JPEG, the alpha channel is dropped
+	ImageFormatBMP                     ///< Windows bitmap
+	ImageFormatTGA                     ///< Truevision TGA
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Options for Image.Encode, the zero value selects the defaults
+type EncodeOptions struct {
+	Quality     int                  // JPEG quality in [1, 100], 0 means jpeg.DefaultQuality
+	Compression png.CompressionLevel // PNG compression level
+	RLE         bool                 // Run-length encode TGA images
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Return the name of the format, e.g. "png"
+func (this ImageFormat) String() string {
+	switch this {
+	case ImageFormatPNG:
+		return "png"
+	case ImageFormatJPEG:
+		return "jpeg"
+	case ImageFormatBMP:
+		return "bmp"
+	case ImageFormatTGA:
+		return "tga"
+	}
+	return fmt.Sprintf("ImageFormat(%d)", int(this))
+}
+
+// Write an image to w in the given format
+//
+// The encoding is done in Go, so no temporary file is needed.
+// JPEG has no alpha channel, transparent pixels are blended
+// over black.
+//
+// 	w:       Destination of the encoded image
+// 	format:  File format to write
+// 	options: Encoding options, can be nil
+func (this *Image) Encode(w io.Writer, format ImageFormat, options *EncodeOptions) error {
+	if options == nil {
+		options = &EncodeOptions{}
+	}
+
+	size := this.GetSize()
+	if size.X == 0 || size.Y == 0 {
+		return fmt.Errorf("Image.Encode: image is empty")
+	}
+
+	width, height := int(size.X), int(size.Y)
+	pixels := this.GetPixelData()
+
+	switch format {
+	case ImageFormatPNG, ImageFormatJPEG:
+		img := &image.NRGBA{Pix: pixels, Stride: width * 4, Rect: image.Rect(0, 0, width, height)}
+		if format == ImageFormatPNG {
+			encoder := png.Encoder{CompressionLevel: options.Compression}
+			return encoder.Encode(w, img)
+		}
+		quality := options.Quality
+		if quality == 0 {
+			quality = jpeg.DefaultQuality
+		}
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	case ImageFormatBMP:
+		return encodeBMP(w, pixels, width, height)
+	case ImageFormatTGA:
+		return encodeTGA(w, pixels, width, height, options.RLE)
+	}
+
+	return fmt.Errorf("Image.Encode: unknown format %v", format)
+}
+
+// Encode an image in the given format and return the file data
+//
+// 	format:  File format to write
+// 	options: Encoding options, can be nil
+func (this *Image) SaveToMemory(format ImageFormat, options *EncodeOptions) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := this.Encode(&buffer, format, options); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Capture the current contents of a render window and encode it to w
+//
+// This is a shortcut for Capture followed by Image.Encode.
+//
+// 	w:       Destination of the encoded image
+// 	format:  File format to write
+// 	options: Encoding options, can be nil
+func (this *RenderWindow) CaptureTo(w io.Writer, format ImageFormat, options *EncodeOptions) error {
+	return this.Capture().Encode(w, format, options)
+}
+
+// Read back the contents of a render texture and encode it to w
+//
+// The contents are the ones of the last call to RenderTexture.Display.
+//
+// 	w:       Destination of the encoded image
+// 	format:  File format to write
+// 	options: Encoding options, can be nil
+func (this *RenderTexture) CaptureTo(w io.Writer, format ImageFormat, options *EncodeOptions) error {
+	return this.GetTexture().CopyToImage().Encode(w, format, options)
+}
+
+// Write RGBA pixels as a bottom-up BMP
+//
+// Opaque images are written with 24 bits per pixel, the others with
+// 32 bits and a BITMAPV4HEADER describing the alpha channel.
+func encodeBMP(w io.Writer, pixels []byte, width, height int) error {
+	opaque := true
+	for i := 3; i < len(pixels); i += 4 {
+		if pixels[i] != 255 {
+			opaque = false
+			break
+		}
+	}
+
+	const fileHeaderSize = 14
+	infoHeaderSize, bitCount, compression := 40, 24, 0 // BI_RGB
+	if !opaque {
+		infoHeaderSize, bitCount, compression = 108, 32, 3 // BI_BITFIELDS
+	}
+	rowSize := (width*bitCount/8 + 3) &^ 3
+	offset := fileHeaderSize + infoHeaderSize
+
+	header := make([]byte, offset)
+	le := binary.LittleEndian
+	header[0], header[1] = 'B', 'M'
+	le.PutUint32(header[2:], uint32(offset+rowSize*height))
+	le.PutUint32(header[10:], uint32(offset))
+	le.PutUint32(header[14:], uint32(infoHeaderSize))
+	le.PutUint32(header[18:], uint32(width))
+	le.PutUint32(header[22:], uint32(height)) // positive height: bottom-up
+	le.PutUint16(header[26:], 1)
+	le.PutUint16(header[28:], uint16(bitCount))
+	le.PutUint32(header[30:], uint32(compression))
+	le.PutUint32(header[34:], uint32(rowSize*height))
+	le.PutUint32(header[38:], 2835) // 72 DPI
+	le.PutUint32(header[42:], 2835)
+	if !opaque {
+		le.PutUint32(header[54:], 0x00ff0000) // red mask
+		le.PutUint32(header[58:], 0x0000ff00) // green mask
+		le.PutUint32(header[62:], 0x000000ff) // blue mask
+		le.PutUint32(header[66:], 0xff000000) // alpha mask
+		copy(header[70:], "BGRs")             // LCS_sRGB
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	row := make([]byte, rowSize)
+	for y := height - 1; y >= 0; y-- {
+		src := pixels[y*width*4 : (y+1)*width*4]
+		for x, i := 0, 0; x < width; x++ {
+			p := src[x*4:]
+			row[i], row[i+1], row[i+2] = p[2], p[1], p[0]
+			i += 3
+			if !opaque {
+				row[i] = p[3]
+				i++
+			}
+		}
+		if _, err := bw.Write(row); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Write RGBA pixels as a top-down 32 bits TGA, optionally run-length encoded
+func encodeTGA(w io.Writer, pixels []byte, width, height int, rle bool) error {
+	header := make([]byte, 18)
+	header[2] = 2 // uncompressed true-color
+	if rle {
+		header[2] = 10 // run-length encoded true-color
+	}
+	binary.LittleEndian.PutUint16(header[12:], uint16(width))
+	binary.LittleEndian.PutUint16(header[14:], uint16(height))
+	header[16] = 32
+	header[17] = 0x28 // top-left origin, 8 alpha bits
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	bgra := func(p []byte) []byte {
+		return []byte{p[2], p[1], p[0], p[3]}
+	}
+
+	for y := 0; y < height; y++ {
+		row := pixels[y*width*4 : (y+1)*width*4]
+
+		if !rle {
+			for x := 0; x < width; x++ {
+				if _, err := bw.Write(bgra(row[x*4:])); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		// packets never cross rows, as recommended by the specification
+		for x := 0; x < width; {
+			run := 1
+			for x+run < width && run < 128 && bytes.Equal(row[x*4:x*4+4], row[(x+run)*4:(x+run)*4+4]) {
+				run++
+			}
+
+			if run > 1 {
+				bw.WriteByte(byte(0x80 | (run - 1)))
+				bw.Write(bgra(row[x*4:]))
+				x += run
+				continue
+			}
+
+			// raw packet up to the next run of two equal pixels
+			count := 1
+			for x+count < width && count < 128 &&
+				(x+count+1 >= width || !bytes.Equal(row[(x+count)*4:(x+count)*4+4], row[(x+count+1)*4:(x+count+1)*4+4])) {
+				count++
+			}
+			bw.WriteByte(byte(count - 1))
+			for i := 0; i < count; i++ {
+				bw.Write(bgra(row[(x+i)*4:]))
+			}
+			x += count
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,566 @@
+// Added by Edgaru089
+
+package gosfml2
+
+// #include <SFML/Graphics/Image.h>
+import "C"
+
+import (
+	"math"
+	"unsafe"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+type ResampleFilter int
+
+const (
+	ResampleNearest  ResampleFilter = iota ///< Nearest neighbour, keeps hard pixel edges
+	ResampleBilinear                       ///< Linear interpolation between the 4 closest pixels
+	ResampleBicubic                        ///< Catmull-Rom interpolation between the 16 closest pixels
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// 4x5 color matrix, given row by row
+//
+// Each output component is a weighted sum of the input
+// components plus an offset, all in the range [0, 1]:
+//	R' = m[0]*R + m[1]*G + m[2]*B + m[3]*A + m[4]
+//	G' = m[5]*R + ...
+type ColorMatrix [20]float32
+
+// Go side copy of the pixels of an Image (RGBA, not premultiplied)
+type pixelBuffer struct {
+	width, height int
+	pix           []byte
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Return the color matrix leaving colors unchanged
+func ColorMatrixIdentity() ColorMatrix {
+	return ColorMatrix{
+		1, 0, 0, 0, 0,
+		0, 1, 0, 0, 0,
+		0, 0, 1, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Return a color matrix adding brightness to red, green and blue
+//
+// 	brightness: Offset in [-1, 1], 0 leaves colors unchanged
+func ColorMatrixBrightness(brightness float32) ColorMatrix {
+	return ColorMatrix{
+		1, 0, 0, 0, brightness,
+		0, 1, 0, 0, brightness,
+		0, 0, 1, 0, brightness,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Return a color matrix scaling red, green and blue around mid-gray
+//
+// 	contrast: Factor, 1 leaves colors unchanged and 0 gives flat gray
+func ColorMatrixContrast(contrast float32) ColorMatrix {
+	offset := (1 - contrast) / 2
+	return ColorMatrix{
+		contrast, 0, 0, 0, offset,
+		0, contrast, 0, 0, offset,
+		0, 0, contrast, 0, offset,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Return a color matrix changing the saturation
+//
+// The luma weights are the Rec. 709 ones used by Color.Luminance.
+//
+// 	saturation: Factor, 1 leaves colors unchanged and 0 gives grayscale
+func ColorMatrixSaturation(saturation float32) ColorMatrix {
+	const lr, lg, lb = 0.2126, 0.7152, 0.0722
+	s := saturation
+	return ColorMatrix{
+		lr*(1-s) + s, lg * (1 - s), lb * (1 - s), 0, 0,
+		lr * (1 - s), lg*(1-s) + s, lb * (1 - s), 0, 0,
+		lr * (1 - s), lg * (1 - s), lb*(1-s) + s, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Combine two color matrices
+//
+// The result applies other first, then this.
+func (this ColorMatrix) Mul(other ColorMatrix) (result ColorMatrix) {
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 5; col++ {
+			var sum float32
+			for k := 0; k < 4; k++ {
+				sum += this[row*5+k] * other[k*5+col]
+			}
+			if col == 4 {
+				sum += this[row*5+4]
+			}
+			result[row*5+col] = sum
+		}
+	}
+	return
+}
+
+// Return a copy of a sub-rectangle of an image
+//
+// The rectangle is clipped to the image, the result is empty
+// if they don't intersect.
+//
+// 	rect: Region to copy
+func (this *Image) Crop(rect IntRect) *Image {
+	src := this.readPixels()
+	_, area := rect.Intersects(IntRect{0, 0, src.width, src.height})
+	return src.crop(area).toImage()
+}
+
+// Return a copy of an image scaled to a new size
+//
+// Bilinear and bicubic filtering widen their kernel when
+// shrinking, so that every source pixel contributes.
+//
+// 	width:  Width of the new image
+// 	height: Height of the new image
+// 	filter: Interpolation to use
+func (this *Image) Resize(width, height uint, filter ResampleFilter) *Image {
+	src := this.readPixels()
+	dst := newPixelBuffer(int(width), int(height))
+	if src.width == 0 || src.height == 0 || dst.width == 0 || dst.height == 0 {
+		return dst.toImage()
+	}
+
+	if filter == ResampleNearest {
+		for y := 0; y < dst.height; y++ {
+			sy := (2*y + 1) * src.height / (2 * dst.height)
+			for x := 0; x < dst.width; x++ {
+				sx := (2*x + 1) * src.width / (2 * dst.width)
+				copy(dst.pix[(y*dst.width+x)*4:], src.pix[(sy*src.width+sx)*4:(sy*src.width+sx)*4+4])
+			}
+		}
+		return dst.toImage()
+	}
+
+	radius, kernel := filterKernel(filter)
+	values := src.premultiplied()
+	values = resampleAxis(values, src.width, src.height, dst.width, true, radius, kernel)
+	values = resampleAxis(values, dst.width, src.height, dst.height, false, radius, kernel)
+	dst.setPremultiplied(values)
+	return dst.toImage()
+}
+
+// Return a copy of an image rotated by 90 degrees clockwise
+func (this *Image) Rotate90() *Image {
+	return this.readPixels().rotateQuarter(true, rotateClockwise).toImage()
+}
+
+// Return a copy of an image rotated by 180 degrees
+func (this *Image) Rotate180() *Image {
+	return this.readPixels().rotateQuarter(false, func(x, y, w, h int) (int, int) { return w - 1 - x, h - 1 - y }).toImage()
+}
+
+// Return a copy of an image rotated by 90 degrees counter-clockwise
+func (this *Image) Rotate270() *Image {
+	return this.readPixels().rotateQuarter(true, func(x, y, w, h int) (int, int) { return y, w - 1 - x }).toImage()
+}
+
+// Return a copy of an image rotated by an arbitrary angle
+//
+// The rotation is clockwise like Transform.Rotate, around the
+// center of the image. The result is sized to contain the whole
+// rotated image, uncovered pixels are transparent.
+//
+// 	angle:  Rotation angle, in degrees
+// 	filter: Interpolation to use
+func (this *Image) Rotate(angle float32, filter ResampleFilter) *Image {
+	src := this.readPixels()
+	sin, cos := sinCosDegrees(angle)
+	fsin, fcos := math.Abs(float64(sin)), math.Abs(float64(cos))
+
+	width := int(math.Ceil(float64(src.width)*fcos + float64(src.height)*fsin - 1e-4))
+	height := int(math.Ceil(float64(src.width)*fsin + float64(src.height)*fcos - 1e-4))
+	dst := newPixelBuffer(width, height)
+	if src.width == 0 || src.height == 0 {
+		return dst.toImage()
+	}
+
+	values := src.premultiplied()
+	out := make([]float32, width*height*4)
+	radius, kernel := filterKernel(filter)
+
+	scx, scy := float32(src.width)/2, float32(src.height)/2
+	dcx, dcy := float32(width)/2, float32(height)/2
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// inverse rotation of the pixel center
+			dx, dy := float32(x)+0.5-dcx, float32(y)+0.5-dcy
+			sx := cos*dx + sin*dy + scx
+			sy := -sin*dx + cos*dy + scy
+			if sx < 0 || sy < 0 || sx >= float32(src.width) || sy >= float32(src.height) {
+				continue
+			}
+
+			pixel := out[(y*width+x)*4 : (y*width+x)*4+4]
+			if filter == ResampleNearest {
+				copy(pixel, values[(int(sy)*src.width+int(sx))*4:])
+			} else {
+				samplePixel(values, src.width, src.height, sx-0.5, sy-0.5, radius, kernel, pixel)
+			}
+		}
+	}
+
+	dst.setPremultiplied(out)
+	return dst.toImage()
+}
+
+// Return a copy of an image blurred with a Gaussian kernel
+//
+// Pixels outside of the image repeat the edge pixels.
+//
+// 	sigma: Standard deviation of the kernel, in pixels
+func (this *Image) GaussianBlur(sigma float32) *Image {
+	if sigma <= 0 {
+		return this.Copy()
+	}
+
+	buffer := this.readPixels()
+	if buffer.width == 0 || buffer.height == 0 {
+		return buffer.toImage()
+	}
+
+	radius := int(math.Ceil(float64(sigma) * 3))
+	weights := make([]float32, 2*radius+1)
+	var total float32
+	for i := range weights {
+		d := float64(i - radius)
+		weights[i] = float32(math.Exp(-d * d / (2 * float64(sigma) * float64(sigma))))
+		total += weights[i]
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+
+	values := buffer.premultiplied()
+	values = convolveAxis(values, buffer.width, buffer.height, weights, true)
+	values = convolveAxis(values, buffer.width, buffer.height, weights, false)
+	buffer.setPremultiplied(values)
+	return buffer.toImage()
+}
+
+// Return a copy of an image with a color matrix applied to every pixel
+//
+// The results are clamped to [0, 1].
+//
+// 	matrix: Color matrix to apply
+func (this *Image) ApplyColorMatrix(matrix ColorMatrix) *Image {
+	buffer := this.readPixels()
+	pix := buffer.pix
+	for i := 0; i < len(pix); i += 4 {
+		r, g, b, a := float32(pix[i])/255, float32(pix[i+1])/255, float32(pix[i+2])/255, float32(pix[i+3])/255
+		for c := 0; c < 4; c++ {
+			m := matrix[c*5 : c*5+5]
+			pix[i+c] = unitToByte(m[0]*r + m[1]*g + m[2]*b + m[3]*a + m[4])
+		}
+	}
+	return buffer.toImage()
+}
+
+// Return a copy of an image with brightness added to every pixel
+//
+// 	brightness: Offset in [-1, 1], 0 leaves the image unchanged
+func (this *Image) AdjustBrightness(brightness float32) *Image {
+	return this.ApplyColorMatrix(ColorMatrixBrightness(brightness))
+}
+
+// Return a copy of an image with its contrast scaled around mid-gray
+//
+// 	contrast: Factor, 1 leaves the image unchanged
+func (this *Image) AdjustContrast(contrast float32) *Image {
+	return this.ApplyColorMatrix(ColorMatrixContrast(contrast))
+}
+
+// Return a copy of an image with its saturation changed
+//
+// 	saturation: Factor, 1 leaves the image unchanged and 0 gives grayscale
+func (this *Image) AdjustSaturation(saturation float32) *Image {
+	return this.ApplyColorMatrix(ColorMatrixSaturation(saturation))
+}
+
+// Return a copy of an image with the red, green and blue components
+// of every pixel multiplied by its alpha
+//
+// Useful before drawing with BlendMode values expecting
+// premultiplied colors.
+func (this *Image) Premultiply() *Image {
+	return this.mapPixels(Color.Premultiply)
+}
+
+// Undo Image.Premultiply, returning a new image
+func (this *Image) Unpremultiply() *Image {
+	return this.mapPixels(Color.Unpremultiply)
+}
+
+// Return a copy of an image with f applied to every pixel
+func (this *Image) mapPixels(f func(Color) Color) *Image {
+	buffer := this.readPixels()
+	pix := buffer.pix
+	for i := 0; i < len(pix); i += 4 {
+		c := f(Color{pix[i], pix[i+1], pix[i+2], pix[i+3]})
+		pix[i], pix[i+1], pix[i+2], pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return buffer.toImage()
+}
+
+// Move every pixel (x, y) of a w*h buffer to the position returned by to,
+// swap tells if the width and height are exchanged
+func (this *pixelBuffer) rotateQuarter(swap bool, to func(x, y, w, h int) (int, int)) *pixelBuffer {
+	src := this
+
+	dst := newPixelBuffer(src.width, src.height)
+	if swap {
+		dst = newPixelBuffer(src.height, src.width)
+	}
+
+	for y := 0; y < src.height; y++ {
+		for x := 0; x < src.width; x++ {
+			dx, dy := to(x, y, src.width, src.height)
+			copy(dst.pix[(dy*dst.width+dx)*4:], src.pix[(y*src.width+x)*4:(y*src.width+x)*4+4])
+		}
+	}
+	return dst
+}
+
+// Destination of a pixel for a 90 degrees clockwise rotation
+func rotateClockwise(x, y, w, h int) (int, int) {
+	return h - 1 - y, x
+}
+
+/////////////////////////////////////
+///		PIXEL BUFFER
+/////////////////////////////////////
+
+func newPixelBuffer(width, height int) *pixelBuffer {
+	return &pixelBuffer{width, height, make([]byte, width*height*4)}
+}
+
+// Copy of an area, which must be inside the buffer
+func (this *pixelBuffer) crop(area IntRect) *pixelBuffer {
+	dst := newPixelBuffer(area.Width, area.Height)
+	for y := 0; y < area.Height; y++ {
+		offset := ((area.Top+y)*this.width + area.Left) * 4
+		copy(dst.pix[y*dst.width*4:(y+1)*dst.width*4], this.pix[offset:])
+	}
+	return dst
+}
+
+// Return the pixels with premultiplied alpha, as floats in [0, 1]
+func (this *pixelBuffer) premultiplied() []float32 {
+	values := make([]float32, len(this.pix))
+	for i := 0; i < len(this.pix); i += 4 {
+		a := float32(this.pix[i+3]) / 255
+		values[i] = float32(this.pix[i]) / 255 * a
+		values[i+1] = float32(this.pix[i+1]) / 255 * a
+		values[i+2] = float32(this.pix[i+2]) / 255 * a
+		values[i+3] = a
+	}
+	return values
+}
+
+// Set the pixels from premultiplied floats in [0, 1]
+func (this *pixelBuffer) setPremultiplied(values []float32) {
+	for i := 0; i < len(this.pix); i += 4 {
+		a := clamp01(values[i+3])
+		this.pix[i+3] = unitToByte(a)
+		if a == 0 {
+			this.pix[i], this.pix[i+1], this.pix[i+2] = 0, 0, 0
+			continue
+		}
+		this.pix[i] = unitToByte(values[i] / a)
+		this.pix[i+1] = unitToByte(values[i+1] / a)
+		this.pix[i+2] = unitToByte(values[i+2] / a)
+	}
+}
+
+// Copy the pixels of an image into Go memory
+func (this *Image) readPixels() *pixelBuffer {
+	size := this.GetSize()
+	return &pixelBuffer{int(size.X), int(size.Y), this.GetPixelData()}
+}
+
+// Replace the pixels of an image, the size may change
+func (this *Image) writePixels(buffer *pixelBuffer) {
+	old := this.cptr
+	this.cptr = buffer.toCPtr()
+	C.sfImage_destroy(old)
+}
+
+// Create a new image holding a copy of the pixels
+func (this *pixelBuffer) toImage() *Image {
+	return newImageFromPtr(this.toCPtr())
+}
+
+func (this *pixelBuffer) toCPtr() *C.sfImage {
+	var pixels *C.sfUint8
+	if len(this.pix) > 0 {
+		pixels = (*C.sfUint8)(unsafe.Pointer(&this.pix[0]))
+	}
+	return C.sfImage_createFromPixels(C.uint(this.width), C.uint(this.height), pixels)
+}
+
+/////////////////////////////////////
+///		RESAMPLING
+/////////////////////////////////////
+
+// Return the radius and the function of the kernel of a filter
+func filterKernel(filter ResampleFilter) (float32, func(float32) float32) {
+	if filter == ResampleBicubic {
+		return 2, catmullRom
+	}
+	return 1, func(x float32) float32 {
+		return max32(0, 1-abs32(x))
+	}
+}
+
+// Catmull-Rom cubic kernel (a = -0.5)
+func catmullRom(x float32) float32 {
+	x = abs32(x)
+	switch {
+	case x < 1:
+		return (1.5*x-2.5)*x*x + 1
+	case x < 2:
+		return ((-0.5*x+2.5)*x-4)*x + 2
+	}
+	return 0
+}
+
+// Resample premultiplied values along one axis
+//
+// The other axis keeps its size. The kernel is stretched when
+// shrinking so that it covers every source pixel.
+func resampleAxis(values []float32, width, height, size int, horizontal bool, radius float32, kernel func(float32) float32) []float32 {
+	srcSize, lines := width, height
+	outWidth, outHeight := size, height
+	if !horizontal {
+		srcSize, lines = height, width
+		outWidth, outHeight = width, size
+	}
+	out := make([]float32, outWidth*outHeight*4)
+
+	scale := float32(srcSize) / float32(size)
+	support := max32(scale, 1)
+
+	for i := 0; i < size; i++ {
+		center := (float32(i)+0.5)*scale - 0.5
+		first := int(math.Floor(float64(center - radius*support)))
+		last := int(math.Ceil(float64(center + radius*support)))
+
+		// the weights are the same for every line
+		var taps []int
+		var weights []float32
+		var total float32
+		for s := first; s <= last; s++ {
+			w := kernel((float32(s) - center) / support)
+			if w == 0 {
+				continue
+			}
+			taps = append(taps, minInt(maxInt(s, 0), srcSize-1))
+			weights = append(weights, w)
+			total += w
+		}
+
+		for line := 0; line < lines; line++ {
+			var dst []float32
+			if horizontal {
+				dst = out[(line*outWidth+i)*4 : (line*outWidth+i)*4+4]
+			} else {
+				dst = out[(i*outWidth+line)*4 : (i*outWidth+line)*4+4]
+			}
+			for t, tap := range taps {
+				var src []float32
+				if horizontal {
+					src = values[(line*width+tap)*4:]
+				} else {
+					src = values[(tap*width+line)*4:]
+				}
+				w := weights[t] / total
+				dst[0] += src[0] * w
+				dst[1] += src[1] * w
+				dst[2] += src[2] * w
+				dst[3] += src[3] * w
+			}
+		}
+	}
+	return out
+}
+
+// Interpolate premultiplied values at (x, y), where integer
+// coordinates are pixel centers
+func samplePixel(values []float32, width, height int, x, y float32, radius float32, kernel func(float32) float32, out []float32) {
+	x0, y0 := int(math.Floor(float64(x-radius)))+1, int(math.Floor(float64(y-radius)))+1
+	x1, y1 := int(math.Floor(float64(x+radius))), int(math.Floor(float64(y+radius)))
+
+	var total float32
+	for sy := y0; sy <= y1; sy++ {
+		wy := kernel(float32(sy) - y)
+		if wy == 0 {
+			continue
+		}
+		row := minInt(maxInt(sy, 0), height-1) * width
+		for sx := x0; sx <= x1; sx++ {
+			w := wy * kernel(float32(sx)-x)
+			if w == 0 {
+				continue
+			}
+			src := values[(row+minInt(maxInt(sx, 0), width-1))*4:]
+			out[0] += src[0] * w
+			out[1] += src[1] * w
+			out[2] += src[2] * w
+			out[3] += src[3] * w
+			total += w
+		}
+	}
+
+	if total != 0 {
+		for c := range out[:4] {
+			out[c] = clamp01(out[c] / total)
+		}
+	}
+}
+
+// Convolve premultiplied values along one axis with odd sized
+// weights, repeating the edge pixels
+func convolveAxis(values []float32, width, height int, weights []float32, horizontal bool) []float32 {
+	out := make([]float32, len(values))
+	radius := len(weights) / 2
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			dst := out[(y*width+x)*4 : (y*width+x)*4+4]
+			for k, w := range weights {
+				sx, sy := x, y
+				if horizontal {
+					sx = minInt(maxInt(x+k-radius, 0), width-1)
+				} else {
+					sy = minInt(maxInt(y+k-radius, 0), height-1)
+				}
+				src := values[(sy*width+sx)*4:]
+				dst[0] += src[0] * w
+				dst[1] += src[1] * w
+				dst[2] += src[2] * w
+				dst[3] += src[3] * w
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,340 @@
+// Added by Edgaru089
+
+// Package imagetest compares rendered images against reference PNG files.
+//
+// A typical test renders into a RenderTexture and checks the result:
+//
+//	func TestSprite(t *testing.T) {
+//		img := imagetest.Render(t, 64, 64, func(target *sf.RenderTexture) {
+//			target.Clear(sf.ColorBlack())
+//			target.Draw(sprite, sf.DefaultRenderStates())
+//		})
+//		imagetest.AssertImageMatches(t, img, "testdata/sprite.png", imagetest.Tolerance{Channel: 2})
+//	}
+//
+// Run the tests with -imagetest.update to write the reference images
+// instead of comparing against them. When a comparison fails, the rendered
+// image and a diff image are written next to the reference as
+// <name>.actual.png and <name>.diff.png.
+//
+// On Linux the tests can run without a GPU on Mesa's software rasterizer,
+// so an X server such as Xvfb is all that is needed, e.g.
+//
+//	xvfb-run go test ./...
+//
+// Software rendering also keeps the output identical across machines,
+// which makes small tolerances usable. It is selected by calling
+// UseSoftwareRenderer from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		imagetest.UseSoftwareRenderer()
+//		os.Exit(m.Run())
+//	}
+package imagetest
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sf "github.com/Edgaru089/gosfml2"
+)
+
+/////////////////////////////////////
+///		FLAGS
+/////////////////////////////////////
+
+// Prefixed with the package name so that it doesn't collide with
+// the -update flag of the test packages importing imagetest
+var update = flag.Bool("imagetest.update", false, "write reference images instead of comparing against them")
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Limits under which two images are considered equal, the zero value
+// only accepts identical images
+type Tolerance struct {
+	Channel    uint8   // Largest per-channel difference ignored entirely
+	Pixels     float64 // Fraction of pixels in [0, 1] allowed to differ by more than Channel
+	Perceptual float64 // Largest allowed perceptual difference of a single pixel, in [0, 1]
+}
+
+// Difference metrics between two images of the same size
+type DiffResult struct {
+	Width, Height int
+
+	MaxChannel  [4]uint8   // Largest difference per channel (R, G, B, A)
+	MeanChannel [4]float64 // Mean difference per channel (R, G, B, A)
+
+	DifferentPixels int     // Number of pixels with a channel differing by more than the tolerance
+	MaxPerceptual   float64 // Largest perceptual difference of a pixel, in [0, 1]
+	MeanPerceptual  float64 // Mean perceptual difference over all pixels, in [0, 1]
+
+	Diff *image.NRGBA // Differing pixels in red over a faded copy of the expected image
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Render an image headlessly
+//
+// A RenderTexture of the given size is created, passed to draw and read
+// back once draw returns. The test is skipped if no OpenGL context can be
+// created.
+//
+//	width:  Width of the render texture
+//	height: Height of the render texture
+//	draw:   Function drawing into the render texture
+func Render(t testing.TB, width, height uint, draw func(target *sf.RenderTexture)) *sf.Image {
+	t.Helper()
+	RequireGL(t)
+
+	target, err := sf.NewRenderTexture(width, height, false)
+	if err != nil {
+		t.Fatalf("imagetest: cannot create render texture: %v", err)
+	}
+
+	draw(target)
+	target.Display()
+	return target.GetTexture().CopyToImage()
+}
+
+// Ask Mesa to use its software rasterizer
+//
+// This sets LIBGL_ALWAYS_SOFTWARE=1 for the whole process, unless the
+// variable is already set, and so also affects code outside of imagetest.
+// It must be called before the first OpenGL context is created to have
+// an effect, usually from TestMain. Other OpenGL implementations ignore it.
+func UseSoftwareRenderer() {
+	if _, ok := os.LookupEnv("LIBGL_ALWAYS_SOFTWARE"); !ok {
+		os.Setenv("LIBGL_ALWAYS_SOFTWARE", "1")
+	}
+}
+
+// Skip the test if no OpenGL context can be created
+func RequireGL(t testing.TB) {
+	t.Helper()
+
+	if !sf.HeadlessAvailable() {
+		t.Skip("imagetest: ", sf.ErrNoDisplay)
+	}
+}
+
+// Compare an image against a reference PNG file
+//
+// With -imagetest.update the image is written to path instead and the test passes.
+// Otherwise the test fails if the images differ by more than tolerance,
+// and the rendered image and a diff image are written next to the reference.
+//
+//	img:       Image to check, e.g. a *gosfml2.Image or *gosfml2.Canvas
+//	path:      Reference PNG file, usually under testdata/
+//	tolerance: Accepted differences
+func AssertImageMatches(t testing.TB, img image.Image, path string, tolerance Tolerance) {
+	t.Helper()
+
+	if *update {
+		if err := SavePNG(path, img); err != nil {
+			t.Fatalf("imagetest: cannot update %s: %v", path, err)
+		}
+		return
+	}
+
+	expected, err := LoadPNG(path)
+	if err != nil {
+		t.Fatalf("imagetest: cannot load reference image (run with -imagetest.update to create it): %v", err)
+	}
+
+	result, err := Diff(expected, img, tolerance.Channel)
+	if err != nil {
+		t.Fatalf("imagetest: %s: %v", path, err)
+	}
+
+	if result.Matches(tolerance) {
+		return
+	}
+
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	actualPath, diffPath := base+".actual.png", base+".diff.png"
+	if err := SavePNG(actualPath, img); err != nil {
+		t.Errorf("imagetest: cannot write %s: %v", actualPath, err)
+	}
+	if err := SavePNG(diffPath, result.Diff); err != nil {
+		t.Errorf("imagetest: cannot write %s: %v", diffPath, err)
+	}
+
+	t.Errorf("imagetest: image does not match %s: %v\n\tactual: %s\n\tdiff:   %s", path, result, actualPath, diffPath)
+}
+
+// Compute the difference metrics between two images
+//
+// The images must have the same size, their origins may differ.
+//
+//	expected:  Reference image
+//	actual:    Image to compare
+//	threshold: Largest per-channel difference not counted in DifferentPixels
+func Diff(expected, actual image.Image, threshold uint8) (*DiffResult, error) {
+	eb, ab := expected.Bounds(), actual.Bounds()
+	if eb.Dx() != ab.Dx() || eb.Dy() != ab.Dy() {
+		return nil, fmt.Errorf("size mismatch: expected %dx%d, got %dx%d", eb.Dx(), eb.Dy(), ab.Dx(), ab.Dy())
+	}
+
+	width, height := eb.Dx(), eb.Dy()
+	result := &DiffResult{Width: width, Height: height, Diff: image.NewNRGBA(image.Rect(0, 0, width, height))}
+
+	var sums [4]float64
+	var perceptualSum float64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			e := color.NRGBAModel.Convert(expected.At(eb.Min.X+x, eb.Min.Y+y)).(color.NRGBA)
+			a := color.NRGBAModel.Convert(actual.At(ab.Min.X+x, ab.Min.Y+y)).(color.NRGBA)
+
+			ec := [4]uint8{e.R, e.G, e.B, e.A}
+			ac := [4]uint8{a.R, a.G, a.B, a.A}
+			different := false
+			for i := range ec {
+				d := absDiff(ec[i], ac[i])
+				sums[i] += float64(d)
+				if d > result.MaxChannel[i] {
+					result.MaxChannel[i] = d
+				}
+				if d > threshold {
+					different = true
+				}
+			}
+
+			p := perceptualDelta(e, a)
+			perceptualSum += p
+			if p > result.MaxPerceptual {
+				result.MaxPerceptual = p
+			}
+
+			if different {
+				result.DifferentPixels++
+				result.Diff.SetNRGBA(x, y, color.NRGBA{255, 0, 0, 255})
+			} else {
+				// faded grayscale of the expected image as context
+				gray := uint8(255 - (255-float64(luma(e)))*0.1)
+				result.Diff.SetNRGBA(x, y, color.NRGBA{gray, gray, gray, 255})
+			}
+		}
+	}
+
+	if count := float64(width * height); count > 0 {
+		for i := range sums {
+			result.MeanChannel[i] = sums[i] / count
+		}
+		result.MeanPerceptual = perceptualSum / count
+	}
+
+	return result, nil
+}
+
+// Tell whether the difference is within tolerance
+//
+//	tolerance: Accepted differences, Channel must match the threshold given to Diff
+func (this *DiffResult) Matches(tolerance Tolerance) bool {
+	if this.Width*this.Height == 0 {
+		return true
+	}
+	if float64(this.DifferentPixels)/float64(this.Width*this.Height) > tolerance.Pixels {
+		return false
+	}
+	if tolerance.Perceptual > 0 && this.MaxPerceptual > tolerance.Perceptual {
+		return false
+	}
+	return true
+}
+
+// Summarize the metrics in one line
+func (this *DiffResult) String() string {
+	return fmt.Sprintf("%d of %d pixels differ (%.3f%%), max channel diff RGBA %v, mean %.2f/%.2f/%.2f/%.2f, max perceptual %.4f, mean perceptual %.4f",
+		this.DifferentPixels, this.Width*this.Height, 100*float64(this.DifferentPixels)/math.Max(1, float64(this.Width*this.Height)),
+		this.MaxChannel, this.MeanChannel[0], this.MeanChannel[1], this.MeanChannel[2], this.MeanChannel[3],
+		this.MaxPerceptual, this.MeanPerceptual)
+}
+
+// Load a PNG file
+func LoadPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return png.Decode(file)
+}
+
+// Write an image as a PNG file, creating the parent directories
+func SavePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func luma(c color.NRGBA) uint8 {
+	return uint8((299*uint32(c.R) + 587*uint32(c.G) + 114*uint32(c.B)) / 1000)
+}
+
+// Perceptual distance between two colours in [0, 1]
+//
+// Both colours are blended over white and compared in the YIQ colour
+// space, weighting luma more than chroma as the eye is more sensitive
+// to it (Kotsarenko and Ramos, "Measuring perceived color difference
+// using YIQ NTSC transmission color space").
+func perceptualDelta(a, b color.NRGBA) float64 {
+	if a == b {
+		return 0
+	}
+
+	y1, i1, q1 := yiq(a)
+	y2, i2, q2 := yiq(b)
+	dy, di, dq := y1-y2, i1-i2, q1-q2
+
+	// largest possible value, reached between red and cyan
+	const maxDelta = 35215.0
+	return (0.5053*dy*dy + 0.299*di*di + 0.1957*dq*dq) / maxDelta
+}
+
+func yiq(c color.NRGBA) (y, i, q float64) {
+	alpha := float64(c.A) / 255
+	blend := func(v uint8) float64 {
+		return 255 + (float64(v)-255)*alpha
+	}
+	r, g, b := blend(c.R), blend(c.G), blend(c.B)
+
+	y = 0.29889531*r + 0.58662247*g + 0.11448223*b
+	i = 0.59597799*r - 0.27417610*g - 0.32180189*b
+	q = 0.21147017*r - 0.52261711*g + 0.31114694*b
+	return
+}
@@ -0,0 +1,187 @@
+// Added by Edgaru089
+
+package imagetest
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func filledImage(width, height int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+/////////////////////////////////////
+///		DIFF
+/////////////////////////////////////
+
+func TestDiffIdentical(t *testing.T) {
+	img := filledImage(4, 3, color.NRGBA{10, 20, 30, 255})
+
+	result, err := Diff(img, img, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DifferentPixels != 0 || result.MaxChannel != [4]uint8{} || result.MaxPerceptual != 0 {
+		t.Errorf("identical images: %v", result)
+	}
+	if !result.Matches(Tolerance{}) {
+		t.Error("identical images do not match with the zero tolerance")
+	}
+}
+
+func TestDiffSizeMismatch(t *testing.T) {
+	if _, err := Diff(filledImage(4, 4, color.NRGBA{}), filledImage(4, 5, color.NRGBA{}), 0); err == nil {
+		t.Error("no error for images of different sizes")
+	}
+}
+
+func TestDiffMetrics(t *testing.T) {
+	expected := filledImage(4, 4, color.NRGBA{100, 100, 100, 255})
+	actual := filledImage(4, 4, color.NRGBA{100, 100, 100, 255})
+	actual.SetNRGBA(1, 2, color.NRGBA{110, 100, 96, 255})
+
+	result, err := Diff(expected, actual, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.DifferentPixels != 1 {
+		t.Errorf("DifferentPixels = %d, want 1", result.DifferentPixels)
+	}
+	if want := [4]uint8{10, 0, 4, 0}; result.MaxChannel != want {
+		t.Errorf("MaxChannel = %v, want %v", result.MaxChannel, want)
+	}
+	if want := [4]float64{10.0 / 16, 0, 4.0 / 16, 0}; result.MeanChannel != want {
+		t.Errorf("MeanChannel = %v, want %v", result.MeanChannel, want)
+	}
+	if result.MaxPerceptual <= 0 || math.Abs(result.MeanPerceptual-result.MaxPerceptual/16) > 1e-12 {
+		t.Errorf("MaxPerceptual = %v, MeanPerceptual = %v", result.MaxPerceptual, result.MeanPerceptual)
+	}
+
+	if c := result.Diff.NRGBAAt(1, 2); c != (color.NRGBA{255, 0, 0, 255}) {
+		t.Errorf("diff image at the differing pixel: %v, want red", c)
+	}
+	if c := result.Diff.NRGBAAt(0, 0); c.R != c.G || c.G != c.B || c.A != 255 {
+		t.Errorf("diff image at a matching pixel: %v, want opaque gray", c)
+	}
+
+	//a difference of 4 on blue is under the threshold, 10 on red is not
+	result, _ = Diff(expected, actual, 10)
+	if result.DifferentPixels != 0 {
+		t.Errorf("DifferentPixels = %d with threshold 10, want 0", result.DifferentPixels)
+	}
+}
+
+func TestDiffOrigins(t *testing.T) {
+	big := filledImage(8, 8, color.NRGBA{0, 0, 0, 255})
+	big.SetNRGBA(5, 6, color.NRGBA{255, 255, 255, 255})
+
+	small := filledImage(4, 4, color.NRGBA{0, 0, 0, 255})
+	small.SetNRGBA(1, 2, color.NRGBA{255, 255, 255, 255})
+
+	result, err := Diff(small, big.SubImage(image.Rect(4, 4, 8, 8)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DifferentPixels != 0 {
+		t.Errorf("images with different origins: %v", result)
+	}
+}
+
+func TestDiffResultMatches(t *testing.T) {
+	expected := filledImage(10, 10, color.NRGBA{0, 0, 0, 255})
+	actual := filledImage(10, 10, color.NRGBA{0, 0, 0, 255})
+	actual.SetNRGBA(0, 0, color.NRGBA{255, 255, 255, 255})
+
+	result, err := Diff(expected, actual, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tolerance Tolerance
+		matches   bool
+	}{
+		{Tolerance{}, false},
+		{Tolerance{Pixels: 0.009}, false},
+		{Tolerance{Pixels: 0.01}, true},
+		{Tolerance{Pixels: 0.01, Perceptual: 0.5}, false},
+		{Tolerance{Pixels: 0.01, Perceptual: 1}, true},
+	}
+	for _, test := range tests {
+		if got := result.Matches(test.tolerance); got != test.matches {
+			t.Errorf("Matches(%+v) = %v, want %v", test.tolerance, got, test.matches)
+		}
+	}
+}
+
+/////////////////////////////////////
+///		PERCEPTUAL
+/////////////////////////////////////
+
+func TestPerceptualDeltaRange(t *testing.T) {
+	black := color.NRGBA{0, 0, 0, 255}
+	white := color.NRGBA{255, 255, 255, 255}
+	red := color.NRGBA{255, 0, 0, 255}
+	cyan := color.NRGBA{0, 255, 255, 255}
+
+	if d := perceptualDelta(white, white); d != 0 {
+		t.Errorf("delta of identical colours = %v, want 0", d)
+	}
+	if d := perceptualDelta(red, cyan); d < 0.999 || d > 1 {
+		t.Errorf("delta(red, cyan) = %v, want the maximum of 1", d)
+	}
+	if d := perceptualDelta(black, white); d < 0.9 || d > 1 {
+		t.Errorf("delta(black, white) = %v, want in [0.9, 1]", d)
+	}
+
+	//every pair of corners of the RGB cube stays in [0, 1]
+	corner := func(i int) color.NRGBA {
+		bit := func(n uint) uint8 { return uint8(i>>n&1) * 255 }
+		return color.NRGBA{bit(0), bit(1), bit(2), 255}
+	}
+	for a := 0; a < 8; a++ {
+		for b := 0; b < 8; b++ {
+			if d := perceptualDelta(corner(a), corner(b)); d < 0 || d > 1 {
+				t.Errorf("delta(%v, %v) = %v, out of [0, 1]", corner(a), corner(b), d)
+			}
+		}
+	}
+}
+
+func TestPerceptualDeltaProperties(t *testing.T) {
+	a := color.NRGBA{200, 50, 30, 255}
+	b := color.NRGBA{20, 120, 220, 255}
+	if perceptualDelta(a, b) != perceptualDelta(b, a) {
+		t.Error("perceptualDelta is not symmetric")
+	}
+
+	//luma weighs more than chroma: a gray step is more visible than
+	//a step of the same size on blue alone
+	gray := perceptualDelta(color.NRGBA{128, 128, 128, 255}, color.NRGBA{148, 148, 148, 255})
+	blue := perceptualDelta(color.NRGBA{128, 128, 128, 255}, color.NRGBA{128, 128, 148, 255})
+	if gray <= blue {
+		t.Errorf("gray step %v not larger than blue step %v", gray, blue)
+	}
+
+	//colours are blended over white, so fully transparent is white
+	if d := perceptualDelta(color.NRGBA{0, 0, 0, 0}, color.NRGBA{255, 255, 255, 255}); d != 0 {
+		t.Errorf("delta(transparent, white) = %v, want 0", d)
+	}
+	if d := perceptualDelta(color.NRGBA{0, 0, 0, 0}, color.NRGBA{255, 0, 0, 0}); d != 0 {
+		t.Errorf("delta between transparent colours = %v, want 0", d)
+	}
+}
@@ -0,0 +1,308 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "time"
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// InputState tracks keyboard, mouse and joystick state from events
+//
+// Unlike KeyboardIsKeyPressed and IsMouseButtonPressed, which query
+// the OS directly, InputState only knows what the window reported
+// through its events, so input going to other windows is ignored
+// and everything held is released when the window loses the focus.
+//
+// Feed every event to HandleEvent (or attach the state to an
+// EventDispatcher) and call EndFrame once at the end of each frame
+// to reset the per-frame edges and totals.
+//
+// InputState is not safe for concurrent use.
+type InputState struct {
+	keys      [KeyCount]buttonState
+	mouse     [MouseButtonCount]buttonState
+	joysticks [JoystickCount][JoystickButtonCount]buttonState
+	axes      [JoystickCount][JoystickAxisCount]float32
+
+	mousePos    Vector2i
+	mouseDelta  Vector2i
+	hasMousePos bool
+	wheelDelta  int
+	focused     bool
+}
+
+type buttonState struct {
+	held     bool
+	pressed  bool // went down during the current frame
+	released bool // went up during the current frame
+	since    time.Time
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a new input state with nothing held
+func NewInputState() *InputState {
+	return &InputState{focused: true}
+}
+
+// Update the state from an event
+func (this *InputState) HandleEvent(ev Event) {
+	switch ev := ev.(type) {
+	case EventKeyPressed:
+		if this.validKey(ev.Code) {
+			this.keys[ev.Code].press()
+		}
+	case EventKeyReleased:
+		if this.validKey(ev.Code) {
+			this.keys[ev.Code].release()
+		}
+	case EventMouseButtonPressed:
+		if this.validMouseButton(ev.Button) {
+			this.mouse[ev.Button].press()
+		}
+	case EventMouseButtonReleased:
+		if this.validMouseButton(ev.Button) {
+			this.mouse[ev.Button].release()
+		}
+	case EventMouseMoved:
+		pos := Vector2i{ev.X, ev.Y}
+		if this.hasMousePos {
+			this.mouseDelta = this.mouseDelta.Plus(pos.Minus(this.mousePos))
+		}
+		this.mousePos = pos
+		this.hasMousePos = true
+	case EventMouseWheelMoved:
+		this.wheelDelta += ev.Delta
+	case EventJoystickButtonPressed:
+		if this.validJoystickButton(ev.JoystickId, ev.Button) {
+			this.joysticks[ev.JoystickId][ev.Button].press()
+		}
+	case EventJoystickButtonReleased:
+		if this.validJoystickButton(ev.JoystickId, ev.Button) {
+			this.joysticks[ev.JoystickId][ev.Button].release()
+		}
+	case EventJoystickMoved:
+		if ev.JoystickId < JoystickCount && ev.Axis >= 0 && ev.Axis < JoystickAxisCount {
+			this.axes[ev.JoystickId][ev.Axis] = ev.Position
+		}
+	case EventJoystickDisconnected:
+		if ev.JoystickId < JoystickCount {
+			for i := range this.joysticks[ev.JoystickId] {
+				this.joysticks[ev.JoystickId][i].release()
+			}
+			this.axes[ev.JoystickId] = [JoystickAxisCount]float32{}
+		}
+	case EventMouseLeft:
+		this.hasMousePos = false
+	case EventLostFocus:
+		this.focused = false
+		this.ReleaseAll()
+	case EventGainedFocus:
+		this.focused = true
+	}
+}
+
+// Attach the state to an event dispatcher
+//
+// The state is fed with every event the dispatcher sees and never
+// consumes any. Use a high priority to observe events before other
+// handlers can consume them.
+func (this *InputState) Attach(dispatcher *EventDispatcher, priority int) EventHandlerId {
+	return dispatcher.OnAny(priority, func(ev Event) bool {
+		this.HandleEvent(ev)
+		return false
+	})
+}
+
+// Finish the current frame
+//
+// This resets the just pressed/released edges, the mouse delta
+// and the wheel total.
+func (this *InputState) EndFrame() {
+	for i := range this.keys {
+		this.keys[i].endFrame()
+	}
+	for i := range this.mouse {
+		this.mouse[i].endFrame()
+	}
+	for j := range this.joysticks {
+		for i := range this.joysticks[j] {
+			this.joysticks[j][i].endFrame()
+		}
+	}
+
+	this.mouseDelta = Vector2i{}
+	this.wheelDelta = 0
+}
+
+// Release everything currently held
+//
+// This is done automatically when the window loses the focus.
+func (this *InputState) ReleaseAll() {
+	for i := range this.keys {
+		this.keys[i].release()
+	}
+	for i := range this.mouse {
+		this.mouse[i].release()
+	}
+	for j := range this.joysticks {
+		for i := range this.joysticks[j] {
+			this.joysticks[j][i].release()
+		}
+	}
+}
+
+// Tell whether the window had the focus according to the events seen
+func (this *InputState) HasFocus() bool {
+	return this.focused
+}
+
+/////////////////////////////////////
+// Keyboard
+
+// Tell whether a key went down during the current frame
+func (this *InputState) KeyJustPressed(key KeyCode) bool {
+	return this.validKey(key) && this.keys[key].pressed
+}
+
+// Tell whether a key went up during the current frame
+func (this *InputState) KeyJustReleased(key KeyCode) bool {
+	return this.validKey(key) && this.keys[key].released
+}
+
+// Tell whether a key is currently held down
+func (this *InputState) KeyHeld(key KeyCode) bool {
+	return this.validKey(key) && this.keys[key].held
+}
+
+// Get for how long a key has been held down, 0 if it is not
+func (this *InputState) KeyHoldDuration(key KeyCode) time.Duration {
+	if !this.validKey(key) {
+		return 0
+	}
+	return this.keys[key].holdDuration()
+}
+
+/////////////////////////////////////
+// Mouse
+
+// Tell whether a mouse button went down during the current frame
+func (this *InputState) MouseButtonJustPressed(button MouseButton) bool {
+	return this.validMouseButton(button) && this.mouse[button].pressed
+}
+
+// Tell whether a mouse button went up during the current frame
+func (this *InputState) MouseButtonJustReleased(button MouseButton) bool {
+	return this.validMouseButton(button) && this.mouse[button].released
+}
+
+// Tell whether a mouse button is currently held down
+func (this *InputState) MouseButtonHeld(button MouseButton) bool {
+	return this.validMouseButton(button) && this.mouse[button].held
+}
+
+// Get for how long a mouse button has been held down, 0 if it is not
+func (this *InputState) MouseButtonHoldDuration(button MouseButton) time.Duration {
+	if !this.validMouseButton(button) {
+		return 0
+	}
+	return this.mouse[button].holdDuration()
+}
+
+// Get the last known mouse position, relative to the window
+func (this *InputState) MousePosition() Vector2i {
+	return this.mousePos
+}
+
+// Get how far the mouse moved during the current frame
+func (this *InputState) MouseDelta() Vector2i {
+	return this.mouseDelta
+}
+
+// Get the number of wheel ticks during the current frame (positive is up)
+func (this *InputState) WheelDelta() int {
+	return this.wheelDelta
+}
+
+/////////////////////////////////////
+// Joystick
+
+// Tell whether a joystick button went down during the current frame
+func (this *InputState) JoystickButtonJustPressed(joystick, button uint) bool {
+	return this.validJoystickButton(joystick, button) && this.joysticks[joystick][button].pressed
+}
+
+// Tell whether a joystick button went up during the current frame
+func (this *InputState) JoystickButtonJustReleased(joystick, button uint) bool {
+	return this.validJoystickButton(joystick, button) && this.joysticks[joystick][button].released
+}
+
+// Tell whether a joystick button is currently held down
+func (this *InputState) JoystickButtonHeld(joystick, button uint) bool {
+	return this.validJoystickButton(joystick, button) && this.joysticks[joystick][button].held
+}
+
+// Get for how long a joystick button has been held down, 0 if it is not
+func (this *InputState) JoystickButtonHoldDuration(joystick, button uint) time.Duration {
+	if !this.validJoystickButton(joystick, button) {
+		return 0
+	}
+	return this.joysticks[joystick][button].holdDuration()
+}
+
+// Get the last known position of a joystick axis, in range [-100 .. 100]
+func (this *InputState) JoystickAxisPosition(joystick uint, axis JoystickAxis) float32 {
+	if joystick >= JoystickCount || axis < 0 || axis >= JoystickAxisCount {
+		return 0
+	}
+	return this.axes[joystick][axis]
+}
+
+/////////////////////////////////////
+// Helpers
+
+func (this *InputState) validKey(key KeyCode) bool {
+	return key >= 0 && key < KeyCount
+}
+
+func (this *InputState) validMouseButton(button MouseButton) bool {
+	return button >= 0 && button < MouseButtonCount
+}
+
+func (this *InputState) validJoystickButton(joystick, button uint) bool {
+	return joystick < JoystickCount && button < JoystickButtonCount
+}
+
+func (this *buttonState) press() {
+	//ignore key repeats
+	if this.held {
+		return
+	}
+	this.held = true
+	this.pressed = true
+	this.since = time.Now()
+}
+
+func (this *buttonState) release() {
+	if !this.held {
+		return
+	}
+	this.held = false
+	this.released = true
+}
+
+func (this *buttonState) endFrame() {
+	this.pressed = false
+	this.released = false
+}
+
+func (this *buttonState) holdDuration() time.Duration {
+	if !this.held {
+		return 0
+	}
+	return time.Since(this.since)
+}
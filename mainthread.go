@@ -0,0 +1,159 @@
+// Added by Edgaru089
+
+package gosfml2
+
+/*
+#include <stdint.h>
+
+#ifdef _WIN32
+#include <windows.h>
+static uint64_t gosfml_currentThreadId(void) { return (uint64_t)GetCurrentThreadId(); }
+#else
+#include <pthread.h>
+static uint64_t gosfml_currentThreadId(void) { return (uint64_t)(uintptr_t)pthread_self(); }
+#endif
+*/
+import "C"
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+type mainCall struct {
+	f    func()
+	done chan struct{}
+}
+
+/////////////////////////////////////
+///		VARS
+/////////////////////////////////////
+
+var (
+	mainThreadId C.uint64_t
+	mainRunning  int32 // set to 1 while Run is serving calls
+
+	mainCalls     = make(chan mainCall)
+	mainStopped   chan struct{} // closed when Run returns
+	mainStopMutex sync.Mutex
+)
+
+// Package initialization happens on the main thread, so the main
+// goroutine is pinned to it from here on.
+func init() {
+	runtime.LockOSThread()
+	mainThreadId = C.gosfml_currentThreadId()
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Run the main thread dispatcher
+//
+// Run must be called from the main goroutine, usually as the
+// only statement of main(). It starts run in a new goroutine and
+// executes every function passed to Call or CallErr on the main
+// OS thread until run returns.
+//
+// Windowing, event polling and OpenGL calls made by this package
+// are marshalled to the main thread automatically while Run is active,
+// so textures can be uploaded and windows changed from any goroutine.
+func Run(run func()) {
+	if !isMainThread() {
+		panic("gosfml2.Run: must be called from the main thread")
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	mainStopMutex.Lock()
+	mainStopped = stopped
+	mainStopMutex.Unlock()
+	atomic.StoreInt32(&mainRunning, 1)
+
+	go func() {
+		defer close(done)
+		run()
+	}()
+
+loop:
+	for {
+		select {
+		case call := <-mainCalls:
+			call.f()
+			close(call.done)
+		case <-done:
+			break loop
+		}
+	}
+
+	atomic.StoreInt32(&mainRunning, 0)
+	mainStopMutex.Lock()
+	mainStopped = nil
+	mainStopMutex.Unlock()
+	close(stopped)
+}
+
+// Execute f on the main thread and wait for it to return
+//
+// If Run is not active or Call is already running on the main
+// thread, f is executed directly.
+func Call(f func()) {
+	if atomic.LoadInt32(&mainRunning) == 0 || isMainThread() {
+		f()
+		return
+	}
+
+	mainStopMutex.Lock()
+	stopped := mainStopped
+	mainStopMutex.Unlock()
+
+	if stopped == nil {
+		f()
+		return
+	}
+
+	call := mainCall{f: f, done: make(chan struct{})}
+	select {
+	case mainCalls <- call:
+		<-call.done
+	case <-stopped:
+		//Run returned before picking the call up
+		f()
+	}
+}
+
+// Execute f on the main thread and return its error
+//
+// See Call for details.
+func CallErr(f func() error) (err error) {
+	Call(func() {
+		err = f()
+	})
+	return
+}
+
+// Execute f on the main thread without waiting for it
+//
+// Used by finalizers, which must never block on the main thread:
+// the main thread may itself be waiting for a garbage collection.
+// If Run is not active, f is executed directly.
+func callAsync(f func()) {
+	if atomic.LoadInt32(&mainRunning) == 0 || isMainThread() {
+		f()
+		return
+	}
+
+	go Call(f)
+}
+
+// Tell whether the caller is running on the main OS thread
+func isMainThread() bool {
+	return C.gosfml_currentThreadId() == mainThreadId
+}
@@ -40,13 +40,17 @@ func NewMusicFromFile(file string) (*Music, error) {
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if cptr := C.sfMusic_createFromFile(cFile); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfMusic_createFromFile(cFile)
+	message := endErrorCapture()
+
+	if cptr != nil {
 		music := &Music{cptr}
 		runtime.SetFinalizer(music, (*Music).destroy)
 		return music, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("music", file, message)
 }
 
 // Create a new music and load it from a file in memory
@@ -63,14 +67,18 @@ func NewMusicFromMemory(data []byte) (*Music, error) {
 		return nil, errors.New("NewMusicFromMemory: len(data)==0")
 	}
 
-	if cptr := C.sfMusic_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data))); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfMusic_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		music := &Music{cptr}
 		runtime.SetFinalizer(music, (*Music).destroy)
 
 		return music, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("music", "", message)
 }
 
 // Destroy a music
@@ -0,0 +1,424 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"errors"
+	"strconv"
+)
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+// Upscaling filter for pixel art, see NewPixelArtShader
+type PixelArtFilter int
+
+const (
+	PixelArtNearest PixelArtFilter = iota ///< Sharp integer scaling, any factor
+	PixelArtScale2x                       ///< Scale2x (also known as EPX), meant for a factor of 2
+	PixelArtScale3x                       ///< Scale3x, meant for a factor of 3
+	PixelArtXBR                           ///< xBR level 1 edge smoothing, meant for a factor of 2
+)
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Return a copy of an image scaled up by an integer factor,
+// every pixel becoming a factor*factor block
+//
+// 	factor: Scale factor, at least 1
+func (this *Image) UpscaleNearest(factor uint) *Image {
+	src := this.readPixels()
+	f := maxInt(int(factor), 1)
+	dst := newPixelBuffer(src.width*f, src.height*f)
+
+	for y := 0; y < dst.height; y++ {
+		for x := 0; x < dst.width; x++ {
+			dst.set(x, y, src.at(x/f, y/f))
+		}
+	}
+	return dst.toImage()
+}
+
+// Return a copy of an image scaled up 2 times with the Scale2x algorithm
+//
+// Scale2x, also known as EPX, rounds the staircases of diagonal
+// lines without adding any new color.
+func (this *Image) Scale2x() *Image {
+	src := this.readPixels()
+	dst := newPixelBuffer(src.width*2, src.height*2)
+
+	for y := 0; y < src.height; y++ {
+		for x := 0; x < src.width; x++ {
+			b, d, e, f, h := src.at(x, y-1), src.at(x-1, y), src.at(x, y), src.at(x+1, y), src.at(x, y+1)
+
+			e0, e1, e2, e3 := e, e, e, e
+			if b != h && d != f {
+				if d == b {
+					e0 = d
+				}
+				if b == f {
+					e1 = f
+				}
+				if d == h {
+					e2 = d
+				}
+				if h == f {
+					e3 = f
+				}
+			}
+
+			dst.set(2*x, 2*y, e0)
+			dst.set(2*x+1, 2*y, e1)
+			dst.set(2*x, 2*y+1, e2)
+			dst.set(2*x+1, 2*y+1, e3)
+		}
+	}
+	return dst.toImage()
+}
+
+// Return a copy of an image scaled up 3 times with the Scale3x algorithm
+func (this *Image) Scale3x() *Image {
+	src := this.readPixels()
+	dst := newPixelBuffer(src.width*3, src.height*3)
+
+	for y := 0; y < src.height; y++ {
+		for x := 0; x < src.width; x++ {
+			a, b, c := src.at(x-1, y-1), src.at(x, y-1), src.at(x+1, y-1)
+			d, e, f := src.at(x-1, y), src.at(x, y), src.at(x+1, y)
+			g, h, i := src.at(x-1, y+1), src.at(x, y+1), src.at(x+1, y+1)
+
+			out := [9]uint32{e, e, e, e, e, e, e, e, e}
+			if b != h && d != f {
+				if d == b {
+					out[0] = d
+				}
+				if (d == b && e != c) || (b == f && e != a) {
+					out[1] = b
+				}
+				if b == f {
+					out[2] = f
+				}
+				if (d == b && e != g) || (d == h && e != a) {
+					out[3] = d
+				}
+				if (b == f && e != i) || (h == f && e != c) {
+					out[5] = f
+				}
+				if d == h {
+					out[6] = d
+				}
+				if (d == h && e != i) || (h == f && e != g) {
+					out[7] = h
+				}
+				if h == f {
+					out[8] = f
+				}
+			}
+
+			for k, pixel := range out {
+				dst.set(3*x+k%3, 3*y+k/3, pixel)
+			}
+		}
+	}
+	return dst.toImage()
+}
+
+// Return a copy of an image scaled up 2 times with an xBR style filter
+//
+// Like Scale2x, but edges are detected from a 5x5 neighbourhood
+// with a perceptual color distance and smoothed by blending, so
+// it also works on anti-aliased sprites.
+func (this *Image) ScaleXBR() *Image {
+	src := this.readPixels()
+	dst := newPixelBuffer(src.width*2, src.height*2)
+
+	for y := 0; y < src.height; y++ {
+		for x := 0; x < src.width; x++ {
+			for _, corner := range [4][2]int{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+				dst.set(2*x+(corner[0]+1)/2, 2*y+(corner[1]+1)/2, xbrCorner(src, x, y, corner[0], corner[1]))
+			}
+		}
+	}
+	return dst.toImage()
+}
+
+// Return the largest integer factor at which content fits in a
+// window, and the viewport centering it
+//
+// The viewport is in the normalized coordinates expected by
+// View.SetViewport, its offset is rounded down to whole window
+// pixels so that the final blit stays pixel-perfect. If the window
+// is smaller than the content the scale is 1 and the content is
+// cropped.
+//
+// 	windowSize:  Size of the render target, in pixels
+// 	contentSize: Size of the low resolution content, in pixels
+func IntegerScaleViewport(windowSize, contentSize Vector2u) (scale uint, viewport FloatRect) {
+	if windowSize.X == 0 || windowSize.Y == 0 || contentSize.X == 0 || contentSize.Y == 0 {
+		return 1, FloatRect{0, 0, 1, 1}
+	}
+
+	scale = minUint(windowSize.X/contentSize.X, windowSize.Y/contentSize.Y)
+	if scale == 0 {
+		scale = 1
+	}
+
+	width, height := int(contentSize.X*scale), int(contentSize.Y*scale)
+	left := (int(windowSize.X) - width) / 2
+	top := (int(windowSize.Y) - height) / 2
+
+	viewport = FloatRect{
+		Left:   float32(left) / float32(windowSize.X),
+		Top:    float32(top) / float32(windowSize.Y),
+		Width:  float32(width) / float32(windowSize.X),
+		Height: float32(height) / float32(windowSize.Y),
+	}
+	return
+}
+
+// Create a view showing contentSize pixels at the largest integer
+// scale fitting in the window, see IntegerScaleViewport
+//
+// 	windowSize:  Size of the render target, in pixels
+// 	contentSize: Size of the low resolution content, in pixels
+func NewIntegerScaleView(windowSize, contentSize Vector2u) *View {
+	_, viewport := IntegerScaleViewport(windowSize, contentSize)
+
+	view := NewViewFromRect(FloatRect{0, 0, float32(contentSize.X), float32(contentSize.Y)})
+	view.SetViewport(viewport)
+	return view
+}
+
+// Create a shader upscaling the current texture with a pixel art filter
+//
+// Draw the low resolution texture (a Sprite of a RenderTexture's
+// texture, for example) scaled up by the factor of the filter,
+// with the shader in its RenderStates. The shader looks up the
+// neighbour texels itself, so the texture doesn't need to be smooth.
+// Call Shader.SetFloatParameter("textureSize", w, h) if the size of
+// the texture changes.
+//
+// 	filter:      Upscaling algorithm
+// 	textureSize: Size of the texture that will be drawn, in pixels
+func NewPixelArtShader(filter PixelArtFilter, textureSize Vector2u) (*Shader, error) {
+	source, ok := pixelArtShaders[filter]
+	if !ok {
+		return nil, errors.New("NewPixelArtShader: unknown filter " + strconv.Itoa(int(filter)))
+	}
+
+	shader, err := NewShaderFromMemory("", pixelArtShaderHeader+source)
+	if err != nil {
+		return nil, err
+	}
+
+	shader.SetCurrentTextureParameter("texture")
+	shader.SetFloatParameter("textureSize", float32(textureSize.X), float32(textureSize.Y))
+	return shader, nil
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Return the pixel at (x, y) packed as R | G<<8 | B<<16 | A<<24,
+// coordinates outside of the buffer repeat the edge pixels
+func (this *pixelBuffer) at(x, y int) uint32 {
+	x = minInt(maxInt(x, 0), this.width-1)
+	y = minInt(maxInt(y, 0), this.height-1)
+	p := this.pix[(y*this.width+x)*4:]
+	return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16 | uint32(p[3])<<24
+}
+
+// Set the pixel at (x, y) from a value packed by at
+func (this *pixelBuffer) set(x, y int, pixel uint32) {
+	p := this.pix[(y*this.width+x)*4:]
+	p[0], p[1], p[2], p[3] = byte(pixel), byte(pixel>>8), byte(pixel>>16), byte(pixel>>24)
+}
+
+// Perceptual distance between two packed pixels, in YUV space
+func xbrDistance(a, b uint32) int {
+	dr := int(a&0xff) - int(b&0xff)
+	dg := int(a>>8&0xff) - int(b>>8&0xff)
+	db := int(a>>16&0xff) - int(b>>16&0xff)
+	da := int(a>>24) - int(b>>24)
+
+	y := absInt(299*dr+587*dg+114*db) / 1000
+	u := absInt(-169*dr-331*dg+500*db) / 1000
+	v := absInt(500*dr-419*dg-81*db) / 1000
+	return 48*y + 7*u + 6*v + 48*absInt(da)
+}
+
+// Return the output pixel of the corner of (x, y) in direction (dx, dy)
+//
+// The neighbourhood is named as in the xBR paper, rotated so that
+// F is the horizontal neighbour and H the vertical one facing
+// the corner:
+//	   A1 B1 C1
+//	A0 A  B  C  C4
+//	D0 D  E  F  F4
+//	G0 G  H  I  I4
+//	   G5 H5 I5
+func xbrCorner(src *pixelBuffer, x, y, dx, dy int) uint32 {
+	p := func(i, j int) uint32 {
+		return src.at(x+i*dx, y+j*dy)
+	}
+	b, c := p(0, -1), p(1, -1)
+	d, e, f, f4 := p(-1, 0), p(0, 0), p(1, 0), p(2, 0)
+	g, h, i, i4 := p(-1, 1), p(0, 1), p(1, 1), p(2, 1)
+	h5, i5 := p(0, 2), p(1, 2)
+
+	// weight of an edge going through E and I, against one through F and H
+	edgeEI := xbrDistance(e, c) + xbrDistance(e, g) + xbrDistance(i, f4) + xbrDistance(i, h5) + 4*xbrDistance(h, f)
+	edgeFH := xbrDistance(h, d) + xbrDistance(h, i5) + xbrDistance(f, i4) + xbrDistance(f, b) + 4*xbrDistance(e, i)
+	if edgeEI >= edgeFH {
+		return e
+	}
+
+	other := h
+	if xbrDistance(e, f) <= xbrDistance(e, h) {
+		other = f
+	}
+
+	// blend halfway, channel by channel
+	var out uint32
+	for shift := uint(0); shift < 32; shift += 8 {
+		out |= ((e>>shift&0xff + other>>shift&0xff + 1) / 2) << shift
+	}
+	return out
+}
+
+/////////////////////////////////////
+///		SHADERS
+/////////////////////////////////////
+
+// Common declarations of the pixel art shaders
+//
+// pixel(offset) returns the texel at an offset, in texels, from the
+// one of the current fragment; subPos() is the position of the
+// fragment inside its texel and cornerDir() points towards the
+// closest corner of the texel.
+const pixelArtShaderHeader = `
+uniform sampler2D texture;
+uniform vec2 textureSize;
+
+vec2 texelPos()
+{
+	return gl_TexCoord[0].xy * textureSize;
+}
+
+vec2 subPos()
+{
+	return fract(texelPos());
+}
+
+vec2 cornerDir()
+{
+	vec2 sub = subPos();
+	return vec2(sub.x < 0.5 ? -1.0 : 1.0, sub.y < 0.5 ? -1.0 : 1.0);
+}
+
+vec4 pixel(vec2 offset)
+{
+	return texture2D(texture, (floor(texelPos()) + 0.5 + offset) / textureSize);
+}
+`
+
+var pixelArtShaders = map[PixelArtFilter]string{
+	PixelArtNearest: `
+void main()
+{
+	gl_FragColor = pixel(vec2(0.0)) * gl_Color;
+}
+`,
+
+	PixelArtScale2x: `
+void main()
+{
+	vec2 dir = cornerDir();
+	vec4 E = pixel(vec2(0.0));
+	vec4 V = pixel(vec2(0.0, dir.y));   // neighbour facing the corner vertically
+	vec4 H = pixel(vec2(dir.x, 0.0));   // neighbour facing the corner horizontally
+	vec4 V2 = pixel(vec2(0.0, -dir.y));
+	vec4 H2 = pixel(vec2(-dir.x, 0.0));
+
+	vec4 color = E;
+	if (V == H && V != H2 && H != V2)
+		color = H;
+	gl_FragColor = color * gl_Color;
+}
+`,
+
+	PixelArtScale3x: `
+void main()
+{
+	vec4 A = pixel(vec2(-1.0, -1.0));
+	vec4 B = pixel(vec2( 0.0, -1.0));
+	vec4 C = pixel(vec2( 1.0, -1.0));
+	vec4 D = pixel(vec2(-1.0,  0.0));
+	vec4 E = pixel(vec2( 0.0,  0.0));
+	vec4 F = pixel(vec2( 1.0,  0.0));
+	vec4 G = pixel(vec2(-1.0,  1.0));
+	vec4 H = pixel(vec2( 0.0,  1.0));
+	vec4 I = pixel(vec2( 1.0,  1.0));
+
+	vec2 cell = floor(subPos() * 3.0);
+	vec4 color = E;
+
+	if (B != H && D != F)
+	{
+		if (cell == vec2(0.0, 0.0) && D == B)
+			color = D;
+		else if (cell == vec2(1.0, 0.0) && ((D == B && E != C) || (B == F && E != A)))
+			color = B;
+		else if (cell == vec2(2.0, 0.0) && B == F)
+			color = F;
+		else if (cell == vec2(0.0, 1.0) && ((D == B && E != G) || (D == H && E != A)))
+			color = D;
+		else if (cell == vec2(2.0, 1.0) && ((B == F && E != I) || (H == F && E != C)))
+			color = F;
+		else if (cell == vec2(0.0, 2.0) && D == H)
+			color = D;
+		else if (cell == vec2(1.0, 2.0) && ((D == H && E != I) || (H == F && E != G)))
+			color = H;
+		else if (cell == vec2(2.0, 2.0) && H == F)
+			color = F;
+	}
+	gl_FragColor = color * gl_Color;
+}
+`,
+
+	PixelArtXBR: `
+float dist(vec4 a, vec4 b)
+{
+	vec4 d = (a - b) * 255.0;
+	float y = abs(0.299 * d.r + 0.587 * d.g + 0.114 * d.b);
+	float u = abs(-0.169 * d.r - 0.331 * d.g + 0.5 * d.b);
+	float v = abs(0.5 * d.r - 0.419 * d.g - 0.081 * d.b);
+	return 48.0 * y + 7.0 * u + 6.0 * v + 48.0 * abs(d.a);
+}
+
+vec4 p(float i, float j)
+{
+	return pixel(vec2(i, j) * cornerDir());
+}
+
+void main()
+{
+	vec4 B = p(0.0, -1.0), C = p(1.0, -1.0);
+	vec4 D = p(-1.0, 0.0), E = p(0.0, 0.0), F = p(1.0, 0.0), F4 = p(2.0, 0.0);
+	vec4 G = p(-1.0, 1.0), H = p(0.0, 1.0), I = p(1.0, 1.0), I4 = p(2.0, 1.0);
+	vec4 H5 = p(0.0, 2.0), I5 = p(1.0, 2.0);
+
+	float edgeEI = dist(E, C) + dist(E, G) + dist(I, F4) + dist(I, H5) + 4.0 * dist(H, F);
+	float edgeFH = dist(H, D) + dist(H, I5) + dist(F, I4) + dist(F, B) + 4.0 * dist(E, I);
+
+	vec4 color = E;
+	if (edgeEI < edgeFH)
+		color = mix(E, dist(E, F) <= dist(E, H) ? F : H, 0.5);
+	gl_FragColor = color * gl_Color;
+}
+`,
+}
@@ -0,0 +1,224 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "reflect"
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// DrawCall is a single draw captured by a RecordingTarget
+type DrawCall struct {
+	Drawer        Drawer        // Drawer given to Draw, nil for direct DrawPrimitives calls
+	Vertices      []Vertex      // Copy of the vertices, in local coordinates
+	PrimitiveType PrimitiveType // Type of the primitives
+	RenderStates  RenderStates  // States the vertices were drawn with
+	View          *View         // Copy of the view active at the time of the draw
+}
+
+// RecordingTarget is a RenderTarget which records draws instead of rendering them
+//
+// It needs no OpenGL context, so code drawing on a RenderTarget can
+// be tested with a plain go test. Sprites and shapes are flattened
+// to the vertices SFML would draw and recorded together with the
+// drawer, texts are recorded without vertices. Views are emulated,
+// so SetView, GetViewport and the coordinate mapping functions
+// behave like on a RenderTexture of the same size.
+type RecordingTarget struct {
+	Calls      []DrawCall // Draws since the last Clear
+	ClearColor Color      // Color of the last Clear
+	Clears     int        // Number of Clear calls
+	Frames     int        // Number of Display calls
+
+	size        Vector2u
+	view        *View
+	defaultView *View
+	drawer      Drawer // Drawer being drawn, attributed to DrawPrimitives calls
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a recording target of the given size
+//
+// 	width:  Width of the emulated target
+// 	height: Height of the emulated target
+func NewRecordingTarget(width, height uint) *RecordingTarget {
+	defaultView := NewViewFromRect(FloatRect{0, 0, float32(width), float32(height)})
+	return &RecordingTarget{size: Vector2u{width, height}, view: defaultView.Copy(), defaultView: defaultView}
+}
+
+// Forget the recorded calls and counters
+func (this *RecordingTarget) Reset() {
+	this.Calls = nil
+	this.ClearColor = Color{}
+	this.Clears, this.Frames = 0, 0
+}
+
+// Clear the target, dropping the calls recorded so far
+func (this *RecordingTarget) Clear(color Color) {
+	this.Calls = nil
+	this.ClearColor = color
+	this.Clears++
+}
+
+// Count a displayed frame
+func (this *RecordingTarget) Display() {
+	this.Frames++
+}
+
+// Change the current active view of the target
+//
+// As with SFML, the view is copied: later changes to it
+// are ignored until it is set again.
+//
+// 	view: New view, nil restores the default view
+func (this *RecordingTarget) SetView(view *View) {
+	if view == nil {
+		view = this.defaultView
+	}
+	this.view = view.Copy()
+}
+
+// Get the current active view of the target
+func (this *RecordingTarget) GetView() *View {
+	return this.view
+}
+
+// Get the default view of the target
+func (this *RecordingTarget) GetDefaultView() *View {
+	return this.defaultView
+}
+
+// Get the viewport of a view applied to this target
+//
+// 	view: Target view, nil for the current view
+func (this *RecordingTarget) GetViewport(view *View) IntRect {
+	return viewportOnTarget(this.size, this.viewOrCurrent(view))
+}
+
+// Convert a point from target coordinates to world coordinates
+//
+// 	point: Pixel to convert
+// 	view:  The view to use for converting the point, nil for the current view
+func (this *RecordingTarget) MapPixelToCoords(point Vector2i, view *View) Vector2f {
+	return mapPixelToCoordsOnTarget(this.size, point, this.viewOrCurrent(view))
+}
+
+// Convert a point from world coordinates to target coordinates
+//
+// 	point: Point to convert
+// 	view:  The view to use for converting the point, nil for the current view
+func (this *RecordingTarget) MapCoordsToPixel(point Vector2f, view *View) Vector2i {
+	return mapCoordsToPixelOnTarget(this.size, point, this.viewOrCurrent(view))
+}
+
+// Does nothing, there are no OpenGL states
+func (this *RecordingTarget) PushGLStates() {}
+
+// Does nothing, there are no OpenGL states
+func (this *RecordingTarget) PopGLStates() {}
+
+// Does nothing, there are no OpenGL states
+func (this *RecordingTarget) ResetGLStates() {}
+
+// Get the size of the emulated target
+func (this *RecordingTarget) GetSize() Vector2u {
+	return this.size
+}
+
+// Draw a drawer and record the primitives it draws
+//
+// If the drawer draws no primitives (e.g. a Text), a single call
+// without vertices is recorded so that the draw is still visible.
+func (this *RecordingTarget) Draw(drawer Drawer, renderStates RenderStates) {
+	if drawer == nil {
+		return
+	}
+
+	parent := this.drawer
+	this.drawer = drawer
+	count := len(this.Calls)
+
+	drawer.Draw(this, renderStates)
+
+	if len(this.Calls) == count {
+		this.record(nil, 0, renderStates)
+	}
+	this.drawer = parent
+}
+
+// Record primitives defined by a slice of vertices
+func (this *RecordingTarget) DrawPrimitives(vertices []Vertex, primType PrimitiveType, renderStates RenderStates) {
+	if len(vertices) > 0 {
+		this.record(vertices, primType, renderStates)
+	}
+}
+
+// Get the recorded calls made while drawing a given drawer
+//
+// Drawers of a type which is not comparable (a slice or a map, for
+// example) cannot be told apart, no calls are returned for them.
+func (this *RecordingTarget) CallsOf(drawer Drawer) (calls []DrawCall) {
+	//comparing two interfaces holding the same non comparable type panics,
+	//different types are compared safely
+	if drawer != nil && !reflect.TypeOf(drawer).Comparable() {
+		return nil
+	}
+
+	for _, call := range this.Calls {
+		if call.Drawer == drawer {
+			calls = append(calls, call)
+		}
+	}
+	return
+}
+
+// Get the vertices of a call with the transform of its render states applied
+func (this *DrawCall) WorldVertices() []Vertex {
+	vertices := make([]Vertex, len(this.Vertices))
+	for i, vertex := range this.Vertices {
+		vertex.Position = this.RenderStates.Transform.TransformPoint(vertex.Position)
+		vertices[i] = vertex
+	}
+	return vertices
+}
+
+// Get the bounding rectangle of a call in world coordinates
+func (this *DrawCall) GetGlobalBounds() FloatRect {
+	vertices := this.WorldVertices()
+	if len(vertices) == 0 {
+		return FloatRect{}
+	}
+
+	minX, minY := vertices[0].Position.X, vertices[0].Position.Y
+	maxX, maxY := minX, minY
+	for _, vertex := range vertices[1:] {
+		minX, minY = min32(minX, vertex.Position.X), min32(minY, vertex.Position.Y)
+		maxX, maxY = max32(maxX, vertex.Position.X), max32(maxY, vertex.Position.Y)
+	}
+	return FloatRect{minX, minY, maxX - minX, maxY - minY}
+}
+
+func (this *RecordingTarget) record(vertices []Vertex, primType PrimitiveType, renderStates RenderStates) {
+	call := DrawCall{Drawer: this.drawer, PrimitiveType: primType, RenderStates: renderStates, View: this.view.Copy()}
+	if len(vertices) > 0 {
+		call.Vertices = append([]Vertex(nil), vertices...)
+	}
+	this.Calls = append(this.Calls, call)
+}
+
+func (this *RecordingTarget) viewOrCurrent(view *View) *View {
+	if view == nil {
+		return this.view
+	}
+	return view
+}
+
+/////////////////////////////////////
+///		TEST
+/////////////////////////////////////
+
+var _ RenderTarget = (*RecordingTarget)(nil)
@@ -0,0 +1,246 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "testing"
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// A shape drawn and checked by the geometry tests, GetLocalBounds is
+// computed by CSFML
+type drawableShape interface {
+	mirroredDrawable
+	Drawer
+	GetLocalBounds() FloatRect
+}
+
+// A drawer whose type is not comparable
+type vertexSliceDrawer []Vertex
+
+func (this vertexSliceDrawer) Draw(target RenderTarget, renderStates RenderStates) {
+	target.DrawPrimitives(this, PrimitiveTriangles, renderStates)
+}
+
+// Bounding rectangle of all the vertices recorded, in world coordinates
+func recordedBounds(calls []DrawCall) (bounds FloatRect) {
+	for i, call := range calls {
+		if i == 0 {
+			bounds = call.GetGlobalBounds()
+		} else {
+			bounds = bounds.Union(call.GetGlobalBounds())
+		}
+	}
+	return
+}
+
+/////////////////////////////////////
+///		RECORDING
+/////////////////////////////////////
+
+func TestRecordingTargetRecordsSprite(t *testing.T) {
+	target := NewRecordingTarget(64, 48)
+
+	sprite, err := NewSprite(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sprite.SetTextureRect(IntRect{2, 4, 16, 8})
+	sprite.SetColor(Color{1, 2, 3, 255})
+	sprite.SetPosition(Vector2f{10, 20})
+	sprite.SetRotation(15)
+
+	target.Clear(Color{9, 9, 9, 255})
+	target.Draw(sprite, DefaultRenderStates())
+	target.DrawPrimitives([]Vertex{{}, {}, {}}, PrimitiveTriangles, DefaultRenderStates())
+	target.Display()
+
+	if target.Clears != 1 || target.Frames != 1 || target.ClearColor != (Color{9, 9, 9, 255}) {
+		t.Errorf("Clears = %d, Frames = %d, ClearColor = %v", target.Clears, target.Frames, target.ClearColor)
+	}
+	if len(target.Calls) != 2 {
+		t.Fatalf("%d calls recorded, want 2", len(target.Calls))
+	}
+
+	calls := target.CallsOf(sprite)
+	if len(calls) != 1 {
+		t.Fatalf("%d calls recorded for the sprite, want 1", len(calls))
+	}
+	call := calls[0]
+
+	if call.PrimitiveType != PrimitiveTrianglesStrip {
+		t.Errorf("primitive type %v, want a triangle strip", call.PrimitiveType)
+	}
+	want := spriteGeometry(IntRect{2, 4, 16, 8}, Color{1, 2, 3, 255})
+	if len(call.Vertices) != len(want) {
+		t.Fatalf("%d vertices, want %d", len(call.Vertices), len(want))
+	}
+	for i := range want {
+		if call.Vertices[i] != want[i] {
+			t.Errorf("vertex %d: %v, want %v", i, call.Vertices[i], want[i])
+		}
+	}
+	assertTransformNear(t, "sprite transform", call.RenderStates.Transform, sprite.GetTransform())
+
+	sprite.flush()
+	assertRectNear(t, "sprite bounds", call.GetGlobalBounds(), csfmlSpriteState(sprite).GlobalBounds)
+
+	if direct := target.CallsOf(nil); len(direct) != 1 || direct[0].PrimitiveType != PrimitiveTriangles {
+		t.Errorf("direct DrawPrimitives calls: %v", direct)
+	}
+}
+
+func TestRecordingTargetCallsOfNonComparable(t *testing.T) {
+	target := NewRecordingTarget(16, 16)
+
+	sprite, err := NewSprite(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sprite.SetTextureRect(IntRect{0, 0, 4, 4})
+
+	drawer := vertexSliceDrawer{{}, {}, {}}
+	target.Draw(drawer, DefaultRenderStates())
+	target.Draw(sprite, DefaultRenderStates())
+
+	if calls := target.CallsOf(drawer); calls != nil {
+		t.Errorf("CallsOf a non comparable drawer returned %d calls, want none", len(calls))
+	}
+	if calls := target.CallsOf(sprite); len(calls) != 1 {
+		t.Errorf("CallsOf the sprite returned %d calls, want 1", len(calls))
+	}
+}
+
+/////////////////////////////////////
+///		GEOMETRY
+/////////////////////////////////////
+
+// The recorded vertices of a shape must cover the bounds CSFML computes
+// from its own geometry, so that the layouts match
+func TestShapeGeometryMatchesCSFML(t *testing.T) {
+	rectangle, err := NewRectangleShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rectangle.SetSize(Vector2f{40, 20})
+	rectangle.SetOutlineThickness(3)
+
+	circle, err := NewCircleShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	circle.SetRadius(10)
+	circle.SetPointCount(7)
+	circle.SetOutlineThickness(-2)
+
+	convex, err := NewConvexShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	convex.SetPointCount(4)
+	convex.SetPoint(0, Vector2f{0, 0})
+	convex.SetPoint(1, Vector2f{30, -10})
+	convex.SetPoint(2, Vector2f{45, 25})
+	convex.SetPoint(3, Vector2f{5, 35})
+	convex.SetOutlineThickness(1.5)
+
+	bare, err := NewConvexShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bare.SetPointCount(3)
+	bare.SetPoint(0, Vector2f{-5, 0})
+	bare.SetPoint(1, Vector2f{10, 2})
+	bare.SetPoint(2, Vector2f{3, 12})
+
+	shapes := []struct {
+		name     string
+		shape    drawableShape
+		reported func() csfmlDrawableState
+		outline  bool
+	}{
+		{"rectangle", rectangle, func() csfmlDrawableState { rectangle.flush(); return csfmlRectangleShapeState(rectangle) }, true},
+		{"circle", circle, func() csfmlDrawableState { circle.flush(); return csfmlCircleShapeState(circle) }, true},
+		{"convex", convex, func() csfmlDrawableState { convex.flush(); return csfmlConvexShapeState(convex) }, true},
+		{"convex without outline", bare, func() csfmlDrawableState { bare.flush(); return csfmlConvexShapeState(bare) }, false},
+	}
+
+	for _, test := range shapes {
+		//at the identity the recorded vertices give the local bounds
+		target := NewRecordingTarget(100, 100)
+		target.Draw(test.shape, DefaultRenderStates())
+
+		calls := target.CallsOf(test.shape)
+		want := 1
+		if test.outline {
+			want = 2
+		}
+		if len(calls) != want {
+			t.Errorf("%s: %d calls, want %d", test.name, len(calls), want)
+			continue
+		}
+		if calls[0].PrimitiveType != PrimitiveTrianglesFan {
+			t.Errorf("%s: fill drawn as %v, want a triangle fan", test.name, calls[0].PrimitiveType)
+		}
+		assertRectNear(t, test.name+" local bounds", recordedBounds(calls), test.shape.GetLocalBounds())
+
+		//moved and scaled, without rotation so that the bounds stay tight
+		test.shape.SetPosition(Vector2f{12, -7})
+		test.shape.SetScale(Vector2f{2, 0.5})
+		target.Clear(ColorBlack())
+		target.Draw(test.shape, DefaultRenderStates())
+		assertRectNear(t, test.name+" global bounds", recordedBounds(target.Calls), test.reported().GlobalBounds)
+	}
+}
+
+/////////////////////////////////////
+///		VIEWS
+/////////////////////////////////////
+
+// The emulated views go through View.GetTransform, check them
+// against a RenderTexture mapping with the view computed by CSFML
+func TestRecordingTargetViewMatchesCSFML(t *testing.T) {
+	if !HeadlessAvailable() {
+		t.Skip(ErrNoDisplay)
+	}
+
+	texture, err := NewRenderTexture(200, 100, false)
+	if err != nil {
+		t.Skip(err)
+	}
+	recording := NewRecordingTarget(200, 100)
+
+	views := []*View{
+		texture.GetDefaultView(),
+		NewViewFromRect(FloatRect{-50, 20, 400, 300}),
+	}
+	rotated := NewViewFromRect(FloatRect{10, 10, 80, 60})
+	rotated.SetRotation(30)
+	rotated.SetViewport(FloatRect{0.25, 0, 0.5, 1})
+	views = append(views, rotated)
+
+	points := []Vector2f{{0, 0}, {37.5, 12}, {-80, 140}, {199, 99}}
+	pixels := []Vector2i{{0, 0}, {100, 50}, {17, 93}, {199, 0}}
+
+	for i, view := range views {
+		if got, want := recording.GetViewport(view), texture.GetViewport(view); got != want {
+			t.Errorf("view %d: viewport %v, CSFML gives %v", i, got, want)
+		}
+
+		for _, point := range points {
+			got, want := recording.MapCoordsToPixel(point, view), texture.MapCoordsToPixel(point, view)
+			//rounding may differ by one pixel on exact halves
+			if absInt(got.X-want.X) > 1 || absInt(got.Y-want.Y) > 1 {
+				t.Errorf("view %d: MapCoordsToPixel(%v) = %v, CSFML gives %v", i, point, got, want)
+			}
+		}
+
+		for _, pixel := range pixels {
+			got, want := recording.MapPixelToCoords(pixel, view), texture.MapPixelToCoords(pixel, view)
+			if !floatNear(got.X, want.X) || !floatNear(got.Y, want.Y) {
+				t.Errorf("view %d: MapPixelToCoords(%v) = %v, CSFML gives %v", i, pixel, got, want)
+			}
+		}
+	}
+}
@@ -30,20 +30,32 @@ type IntRect struct {
 ///		FUNCS
 /////////////////////////////////////
 
+/////////////////////////////////////
+// FloatRect
+
 // Check if a point is inside a rectangle's area
 //
 // 	x: X coordinate of the point to test
 // 	y: Y coordinate of the point to test
 func (this FloatRect) Contains(x, y float32) bool {
-	return sfBool2Go(C.sfFloatRect_contains(this.toCPtr(), C.float(x), C.float(y)))
+	minX, minY, maxX, maxY := this.bounds()
+	return x >= minX && x < maxX && y >= minY && y < maxY
 }
 
 // Check if a point is inside a rectangle's area
 //
-// 	x: X coordinate of the point to test
-// 	y: Y coordinate of the point to test
-func (this IntRect) Contains(x, y int) bool {
-	return sfBool2Go(C.sfIntRect_contains(this.toCPtr(), C.int(x), C.int(y)))
+// 	point: Point to test
+func (this FloatRect) ContainsPoint(point Vector2f) bool {
+	return this.Contains(point.X, point.Y)
+}
+
+// Check if another rectangle lies entirely inside a rectangle's area
+//
+// 	other: Rectangle to test
+func (this FloatRect) ContainsRect(other FloatRect) bool {
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+	return oMinX >= minX && oMaxX <= maxX && oMinY >= minY && oMaxY <= maxY
 }
 
 // Check intersection between two rectangles
@@ -51,16 +63,197 @@ func (this IntRect) Contains(x, y int) bool {
 // 	other: Rectangle to test against
 // 	intersection: Overlapping rect
 func (this FloatRect) Intersects(other FloatRect) (test bool, intersection FloatRect) {
-	test = sfBool2Go(C.sfFloatRect_intersects(this.toCPtr(), other.toCPtr(), intersection.toCPtr()))
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+
+	left, top := max32(minX, oMinX), max32(minY, oMinY)
+	right, bottom := min32(maxX, oMaxX), min32(maxY, oMaxY)
+
+	if left < right && top < bottom {
+		return true, FloatRect{left, top, right - left, bottom - top}
+	}
+	return false, FloatRect{}
+}
+
+// Return the smallest rectangle containing both rectangles
+//
+// 	other: Rectangle to merge with
+func (this FloatRect) Union(other FloatRect) FloatRect {
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+
+	left, top := min32(minX, oMinX), min32(minY, oMinY)
+	right, bottom := max32(maxX, oMaxX), max32(maxY, oMaxY)
+	return FloatRect{left, top, right - left, bottom - top}
+}
+
+// Return the position of the top-left corner of a rectangle
+func (this FloatRect) Position() Vector2f {
+	return Vector2f{this.Left, this.Top}
+}
+
+// Return the size of a rectangle
+func (this FloatRect) Size() Vector2f {
+	return Vector2f{this.Width, this.Height}
+}
+
+// Return the center of a rectangle
+func (this FloatRect) Center() Vector2f {
+	return Vector2f{this.Left + this.Width/2, this.Top + this.Height/2}
+}
+
+// Return a rectangle grown by dx on the left and right sides, and dy on the top and bottom
+func (this FloatRect) Expand(dx, dy float32) FloatRect {
+	return FloatRect{this.Left - dx, this.Top - dy, this.Width + 2*dx, this.Height + 2*dy}
+}
+
+// Return a rectangle shrunk by dx on the left and right sides, and dy on the top and bottom
+func (this FloatRect) Inset(dx, dy float32) FloatRect {
+	return this.Expand(-dx, -dy)
+}
+
+// Return a rectangle moved by offset
+func (this FloatRect) Translate(offset Vector2f) FloatRect {
+	return FloatRect{this.Left + offset.X, this.Top + offset.Y, this.Width, this.Height}
+}
+
+// Return the point of a rectangle's area closest to point
+//
+// The result lies within [left, left + width] and [top, top + height].
+func (this FloatRect) ClampPoint(point Vector2f) Vector2f {
+	minX, minY, maxX, maxY := this.bounds()
+	return point.Clamp(Vector2f{minX, minY}, Vector2f{maxX, maxY})
+}
+
+// Convert a rectangle to an IntRect, truncating each field towards zero
+func (this FloatRect) ToIntRect() IntRect {
+	return IntRect{int(this.Left), int(this.Top), int(this.Width), int(this.Height)}
+}
+
+// Return the min and max coordinates of a rectangle, which may have a negative size
+func (this FloatRect) bounds() (minX, minY, maxX, maxY float32) {
+	minX, maxX = this.Left, this.Left+this.Width
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	minY, maxY = this.Top, this.Top+this.Height
+	if minY > maxY {
+		minY, maxY = maxY, minY
+	}
 	return
 }
 
+/////////////////////////////////////
+// IntRect
+
+// Check if a point is inside a rectangle's area
+//
+// 	x: X coordinate of the point to test
+// 	y: Y coordinate of the point to test
+func (this IntRect) Contains(x, y int) bool {
+	minX, minY, maxX, maxY := this.bounds()
+	return x >= minX && x < maxX && y >= minY && y < maxY
+}
+
+// Check if a point is inside a rectangle's area
+//
+// 	point: Point to test
+func (this IntRect) ContainsPoint(point Vector2i) bool {
+	return this.Contains(point.X, point.Y)
+}
+
+// Check if another rectangle lies entirely inside a rectangle's area
+//
+// 	other: Rectangle to test
+func (this IntRect) ContainsRect(other IntRect) bool {
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+	return oMinX >= minX && oMaxX <= maxX && oMinY >= minY && oMaxY <= maxY
+}
+
 // Check intersection between two rectangles
 //
 // 	other: Rectangle to test against
 // 	intersection: Overlapping rect
 func (this IntRect) Intersects(other IntRect) (test bool, intersection IntRect) {
-	test = sfBool2Go(C.sfIntRect_intersects(this.toCPtr(), other.toCPtr(), intersection.toCPtr()))
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+
+	left, top := maxInt(minX, oMinX), maxInt(minY, oMinY)
+	right, bottom := minInt(maxX, oMaxX), minInt(maxY, oMaxY)
+
+	if left < right && top < bottom {
+		return true, IntRect{left, top, right - left, bottom - top}
+	}
+	return false, IntRect{}
+}
+
+// Return the smallest rectangle containing both rectangles
+//
+// 	other: Rectangle to merge with
+func (this IntRect) Union(other IntRect) IntRect {
+	minX, minY, maxX, maxY := this.bounds()
+	oMinX, oMinY, oMaxX, oMaxY := other.bounds()
+
+	left, top := minInt(minX, oMinX), minInt(minY, oMinY)
+	right, bottom := maxInt(maxX, oMaxX), maxInt(maxY, oMaxY)
+	return IntRect{left, top, right - left, bottom - top}
+}
+
+// Return the position of the top-left corner of a rectangle
+func (this IntRect) Position() Vector2i {
+	return Vector2i{this.Left, this.Top}
+}
+
+// Return the size of a rectangle
+func (this IntRect) Size() Vector2i {
+	return Vector2i{this.Width, this.Height}
+}
+
+// Return the center of a rectangle, rounded towards the top-left corner
+func (this IntRect) Center() Vector2i {
+	return Vector2i{this.Left + this.Width/2, this.Top + this.Height/2}
+}
+
+// Return a rectangle grown by dx on the left and right sides, and dy on the top and bottom
+func (this IntRect) Expand(dx, dy int) IntRect {
+	return IntRect{this.Left - dx, this.Top - dy, this.Width + 2*dx, this.Height + 2*dy}
+}
+
+// Return a rectangle shrunk by dx on the left and right sides, and dy on the top and bottom
+func (this IntRect) Inset(dx, dy int) IntRect {
+	return this.Expand(-dx, -dy)
+}
+
+// Return a rectangle moved by offset
+func (this IntRect) Translate(offset Vector2i) IntRect {
+	return IntRect{this.Left + offset.X, this.Top + offset.Y, this.Width, this.Height}
+}
+
+// Return the point of a rectangle's area closest to point
+//
+// The result is a point contained in the rectangle (see Contains),
+// which must not be empty.
+func (this IntRect) ClampPoint(point Vector2i) Vector2i {
+	minX, minY, maxX, maxY := this.bounds()
+	return point.Clamp(Vector2i{minX, minY}, Vector2i{maxX - 1, maxY - 1})
+}
+
+// Convert a rectangle to a FloatRect
+func (this IntRect) ToFloatRect() FloatRect {
+	return FloatRect{float32(this.Left), float32(this.Top), float32(this.Width), float32(this.Height)}
+}
+
+// Return the min and max coordinates of a rectangle, which may have a negative size
+func (this IntRect) bounds() (minX, minY, maxX, maxY int) {
+	minX, maxX = this.Left, this.Left+this.Width
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	minY, maxY = this.Top, this.Top+this.Height
+	if minY > maxY {
+		minY, maxY = maxY, minY
+	}
 	return
 }
 
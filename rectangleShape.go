@@ -17,6 +17,13 @@ import "runtime"
 type RectangleShape struct {
 	cptr    *C.sfRectangleShape
 	texture *Texture //to prevent the GC from deleting the texture
+
+	transformState
+	fillColor     Color
+	outlineColor  Color
+	textureRect   IntRect
+	localBounds   FloatRect
+	boundsUpdated bool
 }
 
 /////////////////////////////////////
@@ -26,7 +33,7 @@ type RectangleShape struct {
 // Create a new rectangle shape
 func NewRectangleShape() (*RectangleShape, error) {
 	if cptr := C.sfRectangleShape_create(); cptr != nil {
-		shape := &RectangleShape{cptr, nil}
+		shape := &RectangleShape{cptr: cptr, transformState: newTransformState(), fillColor: ColorWhite(), outlineColor: ColorWhite()}
 		runtime.SetFinalizer(shape, (*RectangleShape).destroy)
 		return shape, nil
 	}
@@ -36,9 +43,12 @@ func NewRectangleShape() (*RectangleShape, error) {
 
 // Copy an existing rectangle shape
 func (this *RectangleShape) Copy() *RectangleShape {
-	shape := &RectangleShape{C.sfRectangleShape_copy(this.cptr), this.texture}
-	runtime.SetFinalizer(shape, (*RectangleShape).destroy)
-	return shape
+	this.flush()
+
+	shape := *this
+	shape.cptr = C.sfRectangleShape_copy(this.cptr)
+	runtime.SetFinalizer(&shape, (*RectangleShape).destroy)
+	return &shape
 }
 
 // Destroy an existing rectangle shape
@@ -54,7 +64,7 @@ func (this *RectangleShape) destroy() {
 //
 // 	position: New position
 func (this *RectangleShape) SetPosition(pos Vector2f) {
-	C.sfRectangleShape_setPosition(this.cptr, pos.toC())
+	this.setPosition(pos)
 }
 
 // Set the scale factors of a rectangle shape
@@ -65,7 +75,7 @@ func (this *RectangleShape) SetPosition(pos Vector2f) {
 //
 // 	scale: New scale factors
 func (this *RectangleShape) SetScale(scale Vector2f) {
-	C.sfRectangleShape_setScale(this.cptr, scale.toC())
+	this.setScale(scale)
 }
 
 // Set the local origin of a rectangle shape
@@ -79,7 +89,7 @@ func (this *RectangleShape) SetScale(scale Vector2f) {
 //
 // 	origin: New origin
 func (this *RectangleShape) SetOrigin(orig Vector2f) {
-	C.sfRectangleShape_setOrigin(this.cptr, orig.toC())
+	this.setOrigin(orig)
 }
 
 // Set the orientation of a rectangle shape
@@ -90,32 +100,29 @@ func (this *RectangleShape) SetOrigin(orig Vector2f) {
 //
 // 	angle: New rotation, in degrees
 func (this *RectangleShape) SetRotation(rot float32) {
-	C.sfRectangleShape_setRotation(this.cptr, C.float(rot))
+	this.setRotation(rot)
 }
 
 // Get the orientation of a rectangle shape
 //
 // The rotation is always in the range [0, 360].
 func (this *RectangleShape) GetRotation() float32 {
-	return float32(C.sfRectangleShape_getRotation(this.cptr))
+	return this.rotation
 }
 
 // Get the position of a rectangle shape
 func (this *RectangleShape) GetPosition() (position Vector2f) {
-	position.fromC(C.sfRectangleShape_getPosition(this.cptr))
-	return
+	return this.position
 }
 
 // Get the current scale of a rectangle shap
 func (this *RectangleShape) GetScale() (scale Vector2f) {
-	scale.fromC(C.sfRectangleShape_getScale(this.cptr))
-	return
+	return this.scale
 }
 
 // Get the local origin of a rectangle shape
 func (this *RectangleShape) GetOrigin() (origin Vector2f) {
-	origin.fromC(C.sfRectangleShape_getOrigin(this.cptr))
-	return
+	return this.origin
 }
 
 // Move a rectangle shape by a given offset
@@ -123,7 +130,7 @@ func (this *RectangleShape) GetOrigin() (origin Vector2f) {
 // This function adds to the current position of the object,
 // unlike RectangleShape.SetPosition which overwrites it.
 func (this *RectangleShape) Move(offset Vector2f) {
-	C.sfRectangleShape_move(this.cptr, offset.toC())
+	this.move(offset)
 }
 
 // Scale a rectangle shape
@@ -131,7 +138,7 @@ func (this *RectangleShape) Move(offset Vector2f) {
 // This function multiplies the current scale of the object,
 // unlike RectangleShape.SetScale which overwrites it.
 func (this *RectangleShape) Scale(factor Vector2f) {
-	C.sfRectangleShape_scale(this.cptr, factor.toC())
+	this.scaleBy(factor)
 }
 
 // Rotate a rectangle shape
@@ -139,7 +146,7 @@ func (this *RectangleShape) Scale(factor Vector2f) {
 // This function adds to the current rotation of the object,
 // unlike RectangleShape.SetRotation which overwrites it.
 func (this *RectangleShape) Rotate(angle float32) {
-	C.sfRectangleShape_rotate(this.cptr, C.float(angle))
+	this.rotate(angle)
 }
 
 // Change the source texture of a rectangle shape
@@ -152,8 +159,10 @@ func (this *RectangleShape) Rotate(angle float32) {
 // 	texture:   New texture
 // 	resetRect: Should the texture rect be reset to the size of the new texture?
 func (this *RectangleShape) SetTexture(texture *Texture, resetRect bool) {
+	this.flush()
 	C.sfRectangleShape_setTexture(this.cptr, texture.cptr, goBool2C(resetRect))
 	this.texture = texture
+	this.textureRect.fromC(C.sfRectangleShape_getTextureRect(this.cptr))
 }
 
 // Set the sub-rectangle of the texture that a rectangle shape will display
@@ -164,7 +173,8 @@ func (this *RectangleShape) SetTexture(texture *Texture, resetRect bool) {
 //
 // 	rect:  Rectangle defining the region of the texture to display
 func (this *RectangleShape) SetTextureRect(rect IntRect) {
-	C.sfRectangleShape_setTextureRect(this.cptr, rect.toC())
+	this.textureRect = rect
+	this.dirty |= dirtyTextureRect
 }
 
 // Get the source texture of a rectangle shape
@@ -176,8 +186,7 @@ func (this *RectangleShape) GetTexture() *Texture {
 
 // Get the sub-rectangle of the texture displayed by a rectangle shape
 func (this *RectangleShape) GetTextureRect() (rect IntRect) {
-	rect.fromC(C.sfRectangleShape_getTextureRect(this.cptr))
-	return
+	return this.textureRect
 }
 
 // Set the fill color of a rectangle shape
@@ -191,7 +200,8 @@ func (this *RectangleShape) GetTextureRect() (rect IntRect) {
 //
 // 	color: New color of the shape
 func (this *RectangleShape) SetFillColor(color Color) {
-	C.sfRectangleShape_setFillColor(this.cptr, color.toC())
+	this.fillColor = color
+	this.dirty |= dirtyColor
 }
 
 // Set the outline color of a rectangle shape
@@ -201,7 +211,8 @@ func (this *RectangleShape) SetFillColor(color Color) {
 //
 // 	color: New outline color of the shape
 func (this *RectangleShape) SetOutlineColor(color Color) {
-	C.sfRectangleShape_setOutlineColor(this.cptr, color.toC())
+	this.outlineColor = color
+	this.dirty |= dirtyOutlineColor
 }
 
 // Set the thickness of a rectangle shape's outline
@@ -213,11 +224,13 @@ func (this *RectangleShape) SetOutlineColor(color Color) {
 // 	thickness: New outline thickness
 func (this *RectangleShape) SetOutlineThickness(thickness float32) {
 	C.sfRectangleShape_setOutlineThickness(this.cptr, C.float(thickness))
+	this.boundsUpdated = false
 }
 
 // Set the size of a rectangle shape
 func (this *RectangleShape) SetSize(size Vector2f) {
 	C.sfRectangleShape_setSize(this.cptr, size.toC())
+	this.boundsUpdated = false
 }
 
 // Get the size of a rectangle shape
@@ -228,14 +241,12 @@ func (this *RectangleShape) GetSize() (size Vector2f) {
 
 // Get the combined transform of a rectangle shape
 func (this *RectangleShape) GetTransform() (transform Transform) {
-	transform.fromC(C.sfRectangleShape_getTransform(this.cptr))
-	return
+	return this.getTransform()
 }
 
 // Get the inverse of the combined transform of a rectangle shape
 func (this *RectangleShape) GetInverseTransform() (transform Transform) {
-	transform.fromC(C.sfRectangleShape_getInverseTransform(this.cptr))
-	return
+	return this.getInverseTransform()
 }
 
 // Set the fill color of a rectangle shape
@@ -249,14 +260,12 @@ func (this *RectangleShape) GetInverseTransform() (transform Transform) {
 //
 // 	color: New color of the shape
 func (this *RectangleShape) GetFillColor() (color Color) {
-	color.fromC(C.sfRectangleShape_getFillColor(this.cptr))
-	return
+	return this.fillColor
 }
 
 // Get the outline color of a rectangle shape
 func (this *RectangleShape) GetOutlineColor() (color Color) {
-	color.fromC(C.sfRectangleShape_getOutlineColor(this.cptr))
-	return
+	return this.outlineColor
 }
 
 // Get the outline thickness of a rectangle shape
@@ -286,9 +295,14 @@ func (this *RectangleShape) GetPoint(index uint) (point Vector2f) {
 // scale, ...) that are applied to the entity.
 // In other words, this function returns the bounds of the
 // entity in the entity's coordinate system.
+// The bounds are computed by SFML once and then kept until the
+// geometry or the outline thickness changes.
 func (this *RectangleShape) GetLocalBounds() (rect FloatRect) {
-	rect.fromC(C.sfRectangleShape_getLocalBounds(this.cptr))
-	return
+	if !this.boundsUpdated {
+		this.localBounds.fromC(C.sfRectangleShape_getLocalBounds(this.cptr))
+		this.boundsUpdated = true
+	}
+	return this.localBounds
 }
 
 // Get the global bounding rectangle of a rectangle shape
@@ -299,17 +313,57 @@ func (this *RectangleShape) GetLocalBounds() (rect FloatRect) {
 // In other words, this function returns the bounds of the
 // sprite in the global 2D world's coordinate system.
 func (this *RectangleShape) GetGlobalBounds() (rect FloatRect) {
-	rect.fromC(C.sfRectangleShape_getGlobalBounds(this.cptr))
-	return
+	transform := this.getTransform()
+	return transform.TransformRect(this.GetLocalBounds())
 }
 
 //Draws a RectangleShape on a render target
+//
+//Other targets receive the geometry SFML would build, through DrawPrimitives.
 func (this *RectangleShape) Draw(target RenderTarget, renderStates RenderStates) {
+	this.flush()
 	rs := renderStates.toC()
 	switch target.(type) {
 	case *RenderWindow:
 		C.sfRenderWindow_drawRectangleShape(target.(*RenderWindow).cptr, this.cptr, &rs)
 	case *RenderTexture:
 		C.sfRenderTexture_drawRectangleShape(target.(*RenderTexture).cptr, this.cptr, &rs)
+	default:
+		points := make([]Vector2f, this.GetPointCount())
+		for i := range points {
+			points[i] = this.GetPoint(uint(i))
+		}
+		fill, outline := shapeGeometry(points, this.textureRect, this.fillColor, this.outlineColor, this.GetOutlineThickness())
+		drawShapeGeometry(target, renderStates, this.getTransform(), this.texture, fill, outline)
+	}
+}
+
+// Send the state changed since the last flush to the C rectangle shape
+func (this *RectangleShape) flush() {
+	if this.dirty == 0 {
+		return
+	}
+
+	if this.dirty&dirtyPosition != 0 {
+		C.sfRectangleShape_setPosition(this.cptr, this.position.toC())
+	}
+	if this.dirty&dirtyScale != 0 {
+		C.sfRectangleShape_setScale(this.cptr, this.scale.toC())
+	}
+	if this.dirty&dirtyOrigin != 0 {
+		C.sfRectangleShape_setOrigin(this.cptr, this.origin.toC())
+	}
+	if this.dirty&dirtyRotation != 0 {
+		C.sfRectangleShape_setRotation(this.cptr, C.float(this.rotation))
+	}
+	if this.dirty&dirtyColor != 0 {
+		C.sfRectangleShape_setFillColor(this.cptr, this.fillColor.toC())
+	}
+	if this.dirty&dirtyOutlineColor != 0 {
+		C.sfRectangleShape_setOutlineColor(this.cptr, this.outlineColor.toC())
+	}
+	if this.dirty&dirtyTextureRect != 0 {
+		C.sfRectangleShape_setTextureRect(this.cptr, this.textureRect.toC())
 	}
+	this.dirty = 0
 }
@@ -26,6 +26,47 @@ type RenderTarget interface {
 	DrawPrimitives([]Vertex, PrimitiveType, RenderStates)
 }
 
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Viewport of a view on a target of the given size, as computed by sf::RenderTarget
+func viewportOnTarget(size Vector2u, view *View) IntRect {
+	width, height := float32(size.X), float32(size.Y)
+	viewport := view.GetViewport()
+
+	return IntRect{
+		Left:   int(0.5 + width*viewport.Left),
+		Top:    int(0.5 + height*viewport.Top),
+		Width:  int(0.5 + width*viewport.Width),
+		Height: int(0.5 + height*viewport.Height),
+	}
+}
+
+// Convert a pixel of a target of the given size to world coordinates
+func mapPixelToCoordsOnTarget(size Vector2u, point Vector2i, view *View) Vector2f {
+	viewport := viewportOnTarget(size, view)
+	normalized := Vector2f{
+		X: -1 + 2*float32(point.X-viewport.Left)/float32(viewport.Width),
+		Y: 1 - 2*float32(point.Y-viewport.Top)/float32(viewport.Height),
+	}
+
+	inverse := view.GetInverseTransform()
+	return inverse.TransformPoint(normalized)
+}
+
+// Convert world coordinates to a pixel of a target of the given size
+func mapCoordsToPixelOnTarget(size Vector2u, point Vector2f, view *View) Vector2i {
+	transform := view.GetTransform()
+	normalized := transform.TransformPoint(point)
+	viewport := viewportOnTarget(size, view)
+
+	return Vector2i{
+		X: int((normalized.X+1)/2*float32(viewport.Width) + float32(viewport.Left)),
+		Y: int((-normalized.Y+1)/2*float32(viewport.Height) + float32(viewport.Top)),
+	}
+}
+
 /////////////////////////////////////
 ///		TEST
 /////////////////////////////////////
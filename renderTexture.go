@@ -34,8 +34,21 @@ type RenderTexture struct {
 // 	height:      Height of the render texture
 // 	depthBuffer: Do you want a depth-buffer attached? (useful only if you're doing 3D OpenGL on the rendertexture)
 func NewRenderTexture(width, height uint, depthbuffer bool) (*RenderTexture, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	//create the render texture
-	if cptr := C.sfRenderTexture_create(C.uint(width), C.uint(height), goBool2C(depthbuffer)); cptr != nil {
+	var cptr *C.sfRenderTexture
+	var message string
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfRenderTexture_create(C.uint(width), C.uint(height), goBool2C(depthbuffer))
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		renderTexture := &RenderTexture{cptr: cptr}
 		renderTexture.texture = &Texture{C.sfRenderTexture_getTexture(cptr)}
 		renderTexture.defView = &View{C.sfRenderTexture_getDefaultView(cptr)}
@@ -49,14 +62,16 @@ func NewRenderTexture(width, height uint, depthbuffer bool) (*RenderTexture, err
 		return renderTexture, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("render texture", "", message)
 }
 
 // Destroy an existing render texture
 func (this *RenderTexture) destroy() {
-	globalCtxSetActive(true)
-	C.sfRenderTexture_destroy(this.cptr)
-	globalCtxSetActive(false)
+	callAsync(func() {
+		globalCtxSetActive(true)
+		C.sfRenderTexture_destroy(this.cptr)
+		globalCtxSetActive(false)
+	})
 }
 
 // Get the size of the rendering region of a render texture
@@ -69,21 +84,27 @@ func (this *RenderTexture) GetSize() (size Vector2u) {
 //
 // 	active: true to activate, false to deactivate
 func (this *RenderTexture) SetActive(active bool) {
-	C.sfRenderTexture_setActive(this.cptr, goBool2C(active))
+	Call(func() {
+		C.sfRenderTexture_setActive(this.cptr, goBool2C(active))
+	})
 }
 
 // Update the contents of the target texture
 func (this *RenderTexture) Display() {
-	globalMutex.Lock()
-	C.sfRenderTexture_display(this.cptr)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		C.sfRenderTexture_display(this.cptr)
+		globalMutex.Unlock()
+	})
 }
 
 // Clear the rendertexture with the given color
 //
 // 	color: Fill color
 func (this *RenderTexture) Clear(color Color) {
-	C.sfRenderTexture_clear(this.cptr, color.toC())
+	Call(func() {
+		C.sfRenderTexture_clear(this.cptr, color.toC())
+	})
 }
 
 // Change the current active view of a render texture
@@ -161,14 +182,18 @@ func (this *RenderTexture) MapCoordsToPixel(pos Vector2f, view *View) (coords Ve
 
 //Draws a RectangleShape on a render target
 func (this *RenderTexture) Draw(drawer Drawer, renderStates RenderStates) {
-	drawer.Draw(this, renderStates)
+	Call(func() {
+		drawer.Draw(this, renderStates)
+	})
 }
 
 // Draw primitives defined by a slice of vertices
 func (this *RenderTexture) DrawPrimitives(vertices []Vertex, primType PrimitiveType, renderStates RenderStates) {
 	if len(vertices) > 0 {
-		rs := renderStates.toC()
-		C.sfRenderTexture_drawPrimitives(this.cptr, (*C.sfVertex)(unsafe.Pointer(&vertices[0])), C.size_t(len(vertices)), C.sfPrimitiveType(primType), &rs)
+		Call(func() {
+			rs := renderStates.toC()
+			C.sfRenderTexture_drawPrimitives(this.cptr, (*C.sfVertex)(unsafe.Pointer(&vertices[0])), C.size_t(len(vertices)), C.sfPrimitiveType(primType), &rs)
+		})
 	}
 }
 
@@ -189,7 +214,9 @@ func (this *RenderTexture) DrawPrimitives(vertices []Vertex, primType PrimitiveT
 // saved and restored). Take a look at the resetGLStates
 // function if you do so.
 func (this *RenderTexture) PushGLStates() {
-	C.sfRenderTexture_pushGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderTexture_pushGLStates(this.cptr)
+	})
 }
 
 // Restore the previously saved OpenGL render states and matrices
@@ -197,7 +224,9 @@ func (this *RenderTexture) PushGLStates() {
 // See the description of pushGLStates to get a detailed
 // description of these functions.
 func (this *RenderTexture) PopGLStates() {
-	C.sfRenderTexture_popGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderTexture_popGLStates(this.cptr)
+	})
 }
 
 // Reset the internal OpenGL states so that the target is ready for drawing
@@ -208,7 +237,9 @@ func (this *RenderTexture) PopGLStates() {
 // states needed by SFML are set, so that subsequent RenderTexture.Draw
 // calls will work as expected.
 func (this *RenderTexture) ResetGLStates() {
-	C.sfRenderTexture_resetGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderTexture_resetGLStates(this.cptr)
+	})
 }
 
 // Get the target texture of a render texture
@@ -220,7 +251,9 @@ func (this *RenderTexture) GetTexture() *Texture {
 //
 // 	smooth: true to enable smoothing, false to disable it
 func (this *RenderTexture) SetSmooth(smooth bool) {
-	C.sfRenderTexture_setSmooth(this.cptr, goBool2C(smooth))
+	Call(func() {
+		C.sfRenderTexture_setSmooth(this.cptr, goBool2C(smooth))
+	})
 }
 
 // Tell whether the smooth filter is enabled or not for a render texture
@@ -232,7 +265,9 @@ func (this *RenderTexture) IsSmooth() bool {
 //
 // 	repeated: true to enable repeating, false to disable it
 func (this *RenderTexture) SetRepeated(repeated bool) {
-	C.sfRenderTexture_setRepeated(this.cptr, goBool2C(repeated))
+	Call(func() {
+		C.sfRenderTexture_setRepeated(this.cptr, goBool2C(repeated))
+	})
 }
 
 // Tell whether the texture is repeated or not
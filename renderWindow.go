@@ -9,8 +9,10 @@ package gosfml2
 import "C"
 
 import (
+	"context"
 	"errors"
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -19,8 +21,9 @@ import (
 /////////////////////////////////////
 
 type RenderWindow struct {
-	cptr *C.sfRenderWindow
-	view *View
+	cptr  *C.sfRenderWindow
+	view  *View
+	hooks eventHooks
 }
 
 /////////////////////////////////////
@@ -33,15 +36,34 @@ type RenderWindow struct {
 // 	title:           Title of the window
 // 	style:           Window style
 // 	contextSettings: Creation settings
-func NewRenderWindow(videoMode VideoMode, title string, style WindowStyle, contextSettings ContextSettings) (window *RenderWindow) {
+//
+// Returns ErrNoDisplay if HeadlessAvailable reports no display.
+func NewRenderWindow(videoMode VideoMode, title string, style WindowStyle, contextSettings ContextSettings) (*RenderWindow, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	//string conversion
 	utf32 := strToRunes(title)
 
 	//convert contextSettings to C
 	cs := contextSettings.toC()
 
-	//create the window
-	window = &RenderWindow{cptr: C.sfRenderWindow_createUnicode(videoMode.toC(), (*C.sfUint32)(unsafe.Pointer(&utf32[0])), C.sfUint32(style), &cs)}
+	//create the window on the main thread
+	var cptr *C.sfRenderWindow
+	var message string
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfRenderWindow_createUnicode(videoMode.toC(), (*C.sfUint32)(unsafe.Pointer(&utf32[0])), C.sfUint32(style), &cs)
+		message = endErrorCapture()
+	})
+
+	if cptr == nil {
+		return nil, newLoadError("render window", "", message)
+	}
+
+	window := &RenderWindow{cptr: cptr}
 
 	//create a copy of current view
 	window.SetView(newViewFromPtr(C.sfRenderWindow_getView(window.cptr)))
@@ -49,7 +71,7 @@ func NewRenderWindow(videoMode VideoMode, title string, style WindowStyle, conte
 	//GC cleanup
 	runtime.SetFinalizer(window, (*RenderWindow).destroy)
 
-	return window
+	return window, nil
 }
 
 /////////////////////////////////////
@@ -66,12 +88,16 @@ func (this *RenderWindow) GetSettings() (settings ContextSettings) {
 //
 // 	size: New size, in pixels
 func (this *RenderWindow) SetSize(size Vector2u) {
-	C.sfRenderWindow_setSize(this.cptr, size.toC())
+	Call(func() {
+		C.sfRenderWindow_setSize(this.cptr, size.toC())
+	})
 }
 
 // Get the size of the rendering region of a render window
 func (this *RenderWindow) GetSize() (size Vector2u) {
-	size.fromC(C.sfRenderWindow_getSize(this.cptr))
+	Call(func() {
+		size.fromC(C.sfRenderWindow_getSize(this.cptr))
+	})
 	return
 }
 
@@ -81,12 +107,16 @@ func (this *RenderWindow) GetSize() (size Vector2u) {
 //
 // 	pos: New position, in pixels
 func (this *RenderWindow) SetPosition(pos Vector2i) {
-	C.sfRenderWindow_setPosition(this.cptr, pos.toC())
+	Call(func() {
+		C.sfRenderWindow_setPosition(this.cptr, pos.toC())
+	})
 }
 
 // Get the position of a render window
 func (this *RenderWindow) GetPosition() (pos Vector2i) {
-	pos.fromC(C.sfRenderWindow_getPosition(this.cptr))
+	Call(func() {
+		pos.fromC(C.sfRenderWindow_getPosition(this.cptr))
+	})
 	return
 }
 
@@ -97,14 +127,18 @@ func (this *RenderWindow) IsOpen() bool {
 
 // Close a render window (but doesn't destroy the internal data)
 func (this *RenderWindow) Close() {
-	C.sfRenderWindow_close(this.cptr)
+	Call(func() {
+		C.sfRenderWindow_close(this.cptr)
+	})
 }
 
 // Destroy an existing render window
 func (this *RenderWindow) destroy() {
-	globalMutex.Lock()
-	C.sfRenderWindow_destroy(this.cptr)
-	globalMutex.Unlock()
+	callAsync(func() {
+		globalMutex.Lock()
+		C.sfRenderWindow_destroy(this.cptr)
+		globalMutex.Unlock()
+	})
 }
 
 // Change the title of a render window
@@ -113,7 +147,9 @@ func (this *RenderWindow) destroy() {
 func (this *RenderWindow) SetTitle(title string) {
 	utf32 := strToRunes(title)
 
-	C.sfRenderWindow_setUnicodeTitle(this.cptr, (*C.sfUint32)(unsafe.Pointer(&utf32[0])))
+	Call(func() {
+		C.sfRenderWindow_setUnicodeTitle(this.cptr, (*C.sfUint32)(unsafe.Pointer(&utf32[0])))
+	})
 }
 
 // Change a render window's icon
@@ -123,7 +159,9 @@ func (this *RenderWindow) SetTitle(title string) {
 // 	pixels: Slice of pixels, format must be RGBA 32 bits
 func (this *RenderWindow) SetIcon(width, height uint, data []byte) error {
 	if len(data) >= int(width*height*4) {
-		C.sfRenderWindow_setIcon(this.cptr, C.uint(width), C.uint(height), (*C.sfUint8)(&data[0]))
+		Call(func() {
+			C.sfRenderWindow_setIcon(this.cptr, C.uint(width), C.uint(height), (*C.sfUint8)(&data[0]))
+		})
 		return nil
 	}
 	return errors.New("SetIcon: Slice length does not match specified dimensions")
@@ -131,13 +169,83 @@ func (this *RenderWindow) SetIcon(width, height uint, data []byte) error {
 
 // Get the event on top of event queue of a render window, if any, and pop it
 //
+// Events consumed by the attached EventDispatcher are skipped.
+//
 // returns nil if there are no events left.
 func (this *RenderWindow) PollEvent() Event {
+	return this.hooks.poll(this.pollEvent)
+}
+
+// Wait for an event and return it
+//
+// Events consumed by the attached EventDispatcher are skipped.
+func (this *RenderWindow) WaitEvent() Event {
+	return this.hooks.wait(this.waitEvent, this.pollEvent)
+}
+
+// Push an event to the event queue of a render window
+//
+// Pushed events are returned by PollEvent and WaitEvent in the
+// order they were pushed, ahead of the events of the operating
+// system. They are recorded and dispatched like any other event.
+// A WaitEvent call already blocked is not woken up by PushEvent.
+//
+// PushEvent is safe to call from any goroutine.
+//
+// 	ev: Event to push, nil and pointers to events are ignored
+func (this *RenderWindow) PushEvent(ev Event) {
+	this.hooks.push(ev)
+}
+
+// Attach an event dispatcher to a render window
+//
+// Every event is passed to the dispatcher before PollEvent
+// and WaitEvent return it, see EventDispatcher.
+//
+// 	dispatcher: Dispatcher to attach, nil to detach the current one
+func (this *RenderWindow) SetEventDispatcher(dispatcher *EventDispatcher) {
+	this.hooks.setDispatcher(dispatcher)
+}
+
+// Get the event dispatcher attached to a render window, if any
+func (this *RenderWindow) GetEventDispatcher() *EventDispatcher {
+	dispatcher, _, _ := this.hooks.attached()
+	return dispatcher
+}
+
+// Record the events of a render window
+//
+// Every event returned by PollEvent and WaitEvent (before the
+// dispatcher sees it) is passed to the recorder, and each call
+// to Display starts a new frame.
+//
+// 	recorder: Recorder to attach, nil to stop recording
+func (this *RenderWindow) SetEventRecorder(recorder *EventRecorder) {
+	this.hooks.setRecorder(recorder)
+}
+
+// Replay recorded events on a render window
+//
+// While a replayer is attached, PollEvent and WaitEvent return the
+// recorded events of the current frame instead of the events of the
+// operating system, which are discarded. Each call to Display
+// advances the replay by one frame.
+//
+// 	replayer: Replayer to attach, nil to get back to live events
+func (this *RenderWindow) SetEventReplayer(replayer *EventReplayer) {
+	this.hooks.setReplayer(replayer)
+}
+
+// Pop an event from the event queue of the render window
+func (this *RenderWindow) pollEvent() Event {
 	cEvent := C.sfEvent{}
+	var hasEvent C.sfBool
 
-	globalMutex.Lock()
-	hasEvent := C.sfRenderWindow_pollEvent(this.cptr, &cEvent)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		hasEvent = C.sfRenderWindow_pollEvent(this.cptr, &cEvent)
+		globalMutex.Unlock()
+	})
 
 	if hasEvent != 0 {
 		return handleEvent(&cEvent)
@@ -145,13 +253,16 @@ func (this *RenderWindow) PollEvent() Event {
 	return nil
 }
 
-// Wait for an event and return it
-func (this *RenderWindow) WaitEvent() Event {
+// Wait for an event on the event queue of the render window
+func (this *RenderWindow) waitEvent() Event {
 	cEvent := C.sfEvent{}
+	var hasError C.sfBool
 
-	globalMutex.Lock()
-	hasError := C.sfRenderWindow_waitEvent(this.cptr, &cEvent)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		hasError = C.sfRenderWindow_waitEvent(this.cptr, &cEvent)
+		globalMutex.Unlock()
+	})
 
 	if hasError != 0 {
 		return handleEvent(&cEvent)
@@ -159,31 +270,56 @@ func (this *RenderWindow) WaitEvent() Event {
 	return nil
 }
 
+// Wait for an event for at most timeout and return it
+//
+// returns nil if no event arrived in time.
+func (this *RenderWindow) WaitEventTimeout(timeout time.Duration) Event {
+	return waitEventTimeout(this.PollEvent, timeout)
+}
+
+// Deliver the events of a render window on a channel
+//
+// The events are polled through Call. While Run is active this
+// happens on the main thread; without Run the window is polled from
+// another goroutine, which some systems (e.g. macOS) do not allow.
+// The window is polled after every Display, after every PushEvent,
+// and at least 60 times per second.
+// The channel is closed when ctx is done or the window is closed.
+func (this *RenderWindow) Events(ctx context.Context) <-chan Event {
+	return pumpEvents(ctx, &this.hooks, this.PollEvent, this.IsOpen)
+}
+
 // Enable / disable vertical synchronization on a render window
 //
 // 	enabled: true to enable v-sync, false to deactivate
 func (this *RenderWindow) SetVSyncEnabled(enabled bool) {
-	globalMutex.Lock()
-	C.sfRenderWindow_setVerticalSyncEnabled(this.cptr, goBool2C(enabled))
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		C.sfRenderWindow_setVerticalSyncEnabled(this.cptr, goBool2C(enabled))
+		globalMutex.Unlock()
+	})
 }
 
 // Show or hide the mouse cursor on a render window
 //
 // 	visible: true to show, false to hide
 func (this *RenderWindow) SetMouseCursorVisible(visible bool) {
-	C.sfRenderWindow_setMouseCursorVisible(this.cptr, goBool2C(visible))
+	Call(func() {
+		C.sfRenderWindow_setMouseCursorVisible(this.cptr, goBool2C(visible))
+	})
 }
 
 // SetMouseCursor sets the displayed mouse cursor of a window
 //
 // To keep things simple, when cursor is nil, the default arrow cursor is set.
 func (win *RenderWindow) SetMouseCursor(cursor *Cursor) {
-	if cursor == nil {
-		C.sfRenderWindow_setMouseCursor(win.cptr, cursorDefault.cptr)
-	} else {
-		C.sfRenderWindow_setMouseCursor(win.cptr, cursor.cptr)
-	}
+	Call(func() {
+		if cursor == nil {
+			C.sfRenderWindow_setMouseCursor(win.cptr, getCursorDefault().cptr)
+		} else {
+			C.sfRenderWindow_setMouseCursor(win.cptr, cursor.cptr)
+		}
+	})
 }
 
 // Enable or disable automatic key-repeat
@@ -194,14 +330,18 @@ func (win *RenderWindow) SetMouseCursor(cursor *Cursor) {
 //
 // Key repeat is enabled by default.
 func (this *RenderWindow) SetKeyRepeatEnabled(enabled bool) {
-	C.sfRenderWindow_setKeyRepeatEnabled(this.cptr, goBool2C(enabled))
+	Call(func() {
+		C.sfRenderWindow_setKeyRepeatEnabled(this.cptr, goBool2C(enabled))
+	})
 }
 
 // Show or hide a render window
 //
 // 	visible: true to show the window, false to hide it
 func (this *RenderWindow) SetVisible(visible bool) {
-	C.sfRenderWindow_setVisible(this.cptr, goBool2C(visible))
+	Call(func() {
+		C.sfRenderWindow_setVisible(this.cptr, goBool2C(visible))
+	})
 }
 
 // Activate or deactivate a render window as the current target for rendering
@@ -210,9 +350,14 @@ func (this *RenderWindow) SetVisible(visible bool) {
 //
 // return True if operation was successful, false otherwise
 func (this *RenderWindow) SetActive(active bool) bool {
-	globalMutex.Lock()
-	success := sfBool2Go(C.sfRenderWindow_setActive(this.cptr, goBool2C(active)))
-	globalMutex.Unlock()
+	var success bool
+
+	Call(func() {
+		globalMutex.Lock()
+		success = sfBool2Go(C.sfRenderWindow_setActive(this.cptr, goBool2C(active)))
+		globalMutex.Unlock()
+	})
+
 	return success
 }
 
@@ -220,28 +365,38 @@ func (this *RenderWindow) SetActive(active bool) bool {
 //
 // 	limit: Framerate limit, in frames per seconds (use 0 to disable limit)
 func (this *RenderWindow) SetFramerateLimit(limit uint) {
-	C.sfRenderWindow_setFramerateLimit(this.cptr, C.uint(limit))
+	Call(func() {
+		C.sfRenderWindow_setFramerateLimit(this.cptr, C.uint(limit))
+	})
 }
 
 // Change the joystick threshold, ie. the value below which no move event will be generated
 //
 // 	threshold: New threshold, in range [0, 100]
 func (this *RenderWindow) SetJoystickThreshold(threshold float32) {
-	C.sfRenderWindow_setJoystickThreshold(this.cptr, C.float(threshold))
+	Call(func() {
+		C.sfRenderWindow_setJoystickThreshold(this.cptr, C.float(threshold))
+	})
 }
 
 // Display a render window on screen
 func (this *RenderWindow) Display() {
-	globalMutex.Lock()
-	C.sfRenderWindow_display(this.cptr)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		C.sfRenderWindow_display(this.cptr)
+		globalMutex.Unlock()
+	})
+
+	this.hooks.endFrame()
 }
 
 // Clear a render window with the given color
 //
 // 	color: Fill color
 func (this *RenderWindow) Clear(color Color) {
-	C.sfRenderWindow_clear(this.cptr, color.toC())
+	Call(func() {
+		C.sfRenderWindow_clear(this.cptr, color.toC())
+	})
 }
 
 // Get the current active view of a render window
@@ -272,14 +427,18 @@ func (this *RenderWindow) GetViewport(view *View) (viewport IntRect) {
 
 // Draw a drawable object to the render-target
 func (this *RenderWindow) Draw(drawer Drawer, renderStates RenderStates) {
-	drawer.Draw(this, renderStates)
+	Call(func() {
+		drawer.Draw(this, renderStates)
+	})
 }
 
 // Draw primitives defined by a slice of vertices
 func (this *RenderWindow) DrawPrimitives(vertices []Vertex, primType PrimitiveType, renderStates RenderStates) {
 	if len(vertices) > 0 {
-		rs := renderStates.toC()
-		C.sfRenderWindow_drawPrimitives(this.cptr, (*C.sfVertex)(unsafe.Pointer(&vertices[0])), C.size_t(len(vertices)), C.sfPrimitiveType(primType), &rs)
+		Call(func() {
+			rs := renderStates.toC()
+			C.sfRenderWindow_drawPrimitives(this.cptr, (*C.sfVertex)(unsafe.Pointer(&vertices[0])), C.size_t(len(vertices)), C.sfPrimitiveType(primType), &rs)
+		})
 	}
 }
 
@@ -354,7 +513,9 @@ func (this *RenderWindow) MapCoordsToPixel(pos Vector2f, view *View) (coords Vec
 // saved and restored). Take a look at the ResetGLStates
 // function if you do so.
 func (this *RenderWindow) PushGLStates() {
-	C.sfRenderWindow_pushGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderWindow_pushGLStates(this.cptr)
+	})
 }
 
 // Restore the previously saved OpenGL render states and matrices
@@ -362,7 +523,9 @@ func (this *RenderWindow) PushGLStates() {
 // See the description of pushGLStates to get a detailed
 // description of these functions.
 func (this *RenderWindow) PopGLStates() {
-	C.sfRenderWindow_popGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderWindow_popGLStates(this.cptr)
+	})
 }
 
 // Reset the internal OpenGL states so that the target is ready for drawing
@@ -373,7 +536,9 @@ func (this *RenderWindow) PopGLStates() {
 // states needed by SFML are set, so that subsequent RenderWindow.Draw
 // calls will work as expected.
 func (this *RenderWindow) ResetGLStates() {
-	C.sfRenderWindow_resetGLStates(this.cptr)
+	Call(func() {
+		C.sfRenderWindow_resetGLStates(this.cptr)
+	})
 }
 
 // Copy the current contents of a render window to an image
@@ -388,7 +553,13 @@ func (this *RenderWindow) ResetGLStates() {
 //
 // return New image containing the captured contents
 func (this *RenderWindow) Capture() *Image {
-	return newImageFromPtr(C.sfRenderWindow_capture(this.cptr))
+	var cptr *C.sfImage
+
+	Call(func() {
+		cptr = C.sfRenderWindow_capture(this.cptr)
+	})
+
+	return newImageFromPtr(cptr)
 }
 
 // Check whether the render window has the input focus
@@ -398,8 +569,11 @@ func (this *RenderWindow) Capture() *Image {
 // events.
 //
 // 	True if window has focus, false otherwise
-func (this *RenderWindow) HasFocus() bool {
-	return sfBool2Go(C.sfRenderWindow_hasFocus(this.cptr))
+func (this *RenderWindow) HasFocus() (focus bool) {
+	Call(func() {
+		focus = sfBool2Go(C.sfRenderWindow_hasFocus(this.cptr))
+	})
+	return
 }
 
 // Request the current render window to be made the active
@@ -412,5 +586,7 @@ func (this *RenderWindow) HasFocus() bool {
 // is free to deny the request.
 // This is not to be confused with RenderWindow.SetActive().
 func (this *RenderWindow) RequestFocus() {
-	C.sfRenderWindow_requestFocus(this.cptr)
+	Call(func() {
+		C.sfRenderWindow_requestFocus(this.cptr)
+	})
 }
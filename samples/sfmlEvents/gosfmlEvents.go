@@ -41,7 +41,10 @@ func main() {
 
 	ticker := time.NewTicker(time.Second / 30)
 
-	renderWindow := sf.NewRenderWindow(sf.VideoMode{800, 600, 32}, "Events (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	renderWindow, err := sf.NewRenderWindow(sf.VideoMode{800, 600, 32}, "Events (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	if err != nil {
+		panic(err)
+	}
 
 	//load font
 	font, _ := sf.NewFontFromFile("resources/Vera.ttf")
@@ -38,7 +38,10 @@ func main() {
 	AITicker := time.NewTicker(time.Second / 10)
 	rand.Seed(time.Now().UnixNano())
 
-	renderWindow := sf.NewRenderWindow(sf.VideoMode{gameWidth, gameHeight, 32}, "Pong (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	renderWindow, err := sf.NewRenderWindow(sf.VideoMode{gameWidth, gameHeight, 32}, "Pong (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	if err != nil {
+		panic(err)
+	}
 
 	// Load the sounds used in the game
 	buffer, _ := sf.NewSoundBufferFromFile("resources/ball.wav")
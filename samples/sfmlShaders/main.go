@@ -21,7 +21,10 @@ func init() {
 func main() {
 	ticker := time.NewTicker(time.Second / 60)
 
-	renderWindow := sf.NewRenderWindow(sf.VideoMode{800, 600, 32}, "Shaders (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	renderWindow, err := sf.NewRenderWindow(sf.VideoMode{800, 600, 32}, "Shaders (GoSFML2)", sf.StyleDefault, sf.DefaultContextSettings())
+	if err != nil {
+		panic(err)
+	}
 
 	// Create the effects
 	effects := [...]Effect{&WaveBlur{}, &Pixelate{}, &StormBlink{}, &Edge{}}
@@ -38,6 +38,10 @@ type Shader struct {
 // 	vertexShaderFile:   Path of the vertex shader file to load, or "" to skip this shader
 // 	fragmentShaderFile: Path of the fragment shader file to load, or "" to skip this shader
 func NewShaderFromFile(vertexShaderFile, fragmentShaderFile string) (*Shader, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	var (
 		cVShader *C.char = nil
 		cFShader *C.char = nil
@@ -53,14 +57,31 @@ func NewShaderFromFile(vertexShaderFile, fragmentShaderFile string) (*Shader, er
 		defer C.free(unsafe.Pointer(cFShader))
 	}
 
-	if cptr := C.sfShader_createFromFile(cVShader, (*C.char)(nil), cFShader); cptr != nil {
+	//report the fragment shader file if both are given
+	path := fragmentShaderFile
+	if len(path) == 0 {
+		path = vertexShaderFile
+	}
+
+	var (
+		cptr    *C.sfShader
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfShader_createFromFile(cVShader, (*C.char)(nil), cFShader)
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		shader := &Shader{cptr}
 		runtime.SetFinalizer(shader, (*Shader).destroy)
 
 		return shader, nil
 	}
 
-	return nil, genericError
+	return nil, newShaderError(path, message)
 }
 
 // Load both the vertex and fragment shaders from source codes in memory
@@ -76,6 +97,10 @@ func NewShaderFromFile(vertexShaderFile, fragmentShaderFile string) (*Shader, er
 // 	vertexShader:   String containing the source code of the vertex shader, or "" to skip this shader
 // 	fragmentShader: String containing the source code of the fragment shader, or "" to skip this shader
 func NewShaderFromMemory(vertexShader, fragmentShader string) (*Shader, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	var (
 		cVShader *C.char = nil
 		cFShader *C.char = nil
@@ -91,20 +116,33 @@ func NewShaderFromMemory(vertexShader, fragmentShader string) (*Shader, error) {
 		defer C.free(unsafe.Pointer(cFShader))
 	}
 
-	if cptr := C.sfShader_createFromMemory(cVShader, (*C.char)(nil), cFShader); cptr != nil {
+	var (
+		cptr    *C.sfShader
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfShader_createFromMemory(cVShader, (*C.char)(nil), cFShader)
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		shader := &Shader{cptr}
 		runtime.SetFinalizer(shader, (*Shader).destroy)
 		return shader, nil
 	}
 
-	return nil, genericError
+	return nil, newShaderError("", message)
 }
 
 // Destroy an existing shader
 func (this *Shader) destroy() {
-	globalCtxSetActive(true)
-	C.sfShader_destroy(this.toCPtr())
-	globalCtxSetActive(false)
+	callAsync(func() {
+		globalCtxSetActive(true)
+		C.sfShader_destroy(this.toCPtr())
+		globalCtxSetActive(false)
+	})
 }
 
 // Change a color parameter of a shader
@@ -125,7 +163,9 @@ func (this *Shader) SetColorParameter(name string, color Color) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
-	C.sfShader_setColorParameter(this.toCPtr(), cname, color.toC())
+	Call(func() {
+		C.sfShader_setColorParameter(this.toCPtr(), cname, color.toC())
+	})
 }
 
 // Change a matrix parameter of a shader
@@ -140,7 +180,9 @@ func (this *Shader) SetTransformParameter(name string, trans Transform) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
-	C.sfShader_setTransformParameter(this.toCPtr(), cname, trans.toC())
+	Call(func() {
+		C.sfShader_setTransformParameter(this.toCPtr(), cname, trans.toC())
+	})
 }
 
 // Change a texture parameter of a shader
@@ -155,7 +197,9 @@ func (this *Shader) SetTextureParameter(name string, texture *Texture) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
-	C.sfShader_setTextureParameter(this.toCPtr(), cname, texture.cptr)
+	Call(func() {
+		C.sfShader_setTextureParameter(this.toCPtr(), cname, texture.cptr)
+	})
 }
 
 // Change a texture parameter of a shader
@@ -171,7 +215,9 @@ func (this *Shader) SetCurrentTextureParameter(name string) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
-	C.sfShader_setCurrentTextureParameter(this.toCPtr(), cname)
+	Call(func() {
+		C.sfShader_setCurrentTextureParameter(this.toCPtr(), cname)
+	})
 }
 
 // Change a n-components vector parameter of a shader
@@ -182,18 +228,22 @@ func (this *Shader) SetFloatParameter(name string, data ...float32) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
-	switch len(data) {
-	case 1:
-		C.sfShader_setFloatParameter(this.toCPtr(), cname, C.float(data[0]))
-	case 2:
-		C.sfShader_setFloat2Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]))
-	case 3:
-		C.sfShader_setFloat3Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]), C.float(data[2]))
-	case 4:
-		C.sfShader_setFloat4Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]), C.float(data[2]), C.float(data[3]))
-	default:
+	if len(data) < 1 || len(data) > 4 {
 		panic("Shader.SetFloatParameter: Invalid amount of data.")
 	}
+
+	Call(func() {
+		switch len(data) {
+		case 1:
+			C.sfShader_setFloatParameter(this.toCPtr(), cname, C.float(data[0]))
+		case 2:
+			C.sfShader_setFloat2Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]))
+		case 3:
+			C.sfShader_setFloat3Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]), C.float(data[2]))
+		case 4:
+			C.sfShader_setFloat4Parameter(this.toCPtr(), cname, C.float(data[0]), C.float(data[1]), C.float(data[2]), C.float(data[3]))
+		}
+	})
 }
 
 // Bind a shader for rendering (activate it)
@@ -204,7 +254,9 @@ func (this *Shader) SetFloatParameter(name string, data ...float32) {
 //
 // 	shader: Shader to bind, can be nil to use no shader
 func BindShader(shader *Shader) {
-	C.sfShader_bind(shader.toCPtr())
+	Call(func() {
+		C.sfShader_bind(shader.toCPtr())
+	})
 }
 
 // Tell whether or not the system supports shaders
@@ -212,8 +264,11 @@ func BindShader(shader *Shader) {
 // This function should always be called before using
 // the shader features. If it returns false, then
 // any attempt to use shaders will fail.
-func ShadersAvailable() bool {
-	return sfBool2Go(C.sfShader_isAvailable())
+func ShadersAvailable() (available bool) {
+	Call(func() {
+		available = sfBool2Go(C.sfShader_isAvailable())
+	})
+	return
 }
 
 /////////////////////////////////////
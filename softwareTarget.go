@@ -0,0 +1,562 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// SoftwareTarget is a RenderTarget rasterizing primitives on the CPU
+//
+// The output does not depend on an OpenGL driver, which makes it
+// usable on headless servers and reproducible across machines.
+// Vertex colors, textures, blend modes, transforms and views are
+// supported; shaders are ignored and texts are not rendered, as
+// their glyphs only exist in OpenGL textures.
+//
+// A Texture lives on the GPU, so its pixels must be registered with
+// RegisterTexture before it can be sampled. Unregistered textures
+// are drawn as if no texture was set.
+type SoftwareTarget struct {
+	pixels      *pixelBuffer
+	view        *View
+	defaultView *View
+	textures    map[*Texture]*softwareTexture
+}
+
+// CPU side copy of a texture
+type softwareTexture struct {
+	pixels   *pixelBuffer
+	smooth   bool
+	repeated bool
+}
+
+// Vertex projected to target pixels, with its attributes in [0, 1] and texels
+type rasterVertex struct {
+	x, y  float32
+	color [4]float32
+	u, v  float32
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a software render target
+//
+// The target is initially transparent black.
+//
+// 	width:  Width of the target, in pixels
+// 	height: Height of the target, in pixels
+func NewSoftwareTarget(width, height uint) *SoftwareTarget {
+	defaultView := NewViewFromRect(FloatRect{0, 0, float32(width), float32(height)})
+	return &SoftwareTarget{
+		pixels:      newPixelBuffer(int(width), int(height)),
+		view:        defaultView.Copy(),
+		defaultView: defaultView,
+		textures:    make(map[*Texture]*softwareTexture),
+	}
+}
+
+// Register the pixels of a texture
+//
+// The image is copied, it must be registered again to reflect later
+// changes. Without an OpenGL context no Texture can be created, any
+// distinct pointer such as new(Texture) can be used as a handle then;
+// note that Sprite.SetTexture leaves the texture rect unchanged for
+// such handles, so it must be set explicitly.
+//
+// 	texture:  Texture the pixels belong to
+// 	image:    Pixels of the texture
+// 	smooth:   Sample with bilinear filtering, as Texture.SetSmooth
+// 	repeated: Repeat coordinates outside the texture, as Texture.SetRepeated
+func (this *SoftwareTarget) RegisterTexture(texture *Texture, image *Image, smooth, repeated bool) {
+	this.textures[texture] = &softwareTexture{pixels: image.readPixels(), smooth: smooth, repeated: repeated}
+}
+
+// Register a texture with its own pixels, smooth and repeated states
+//
+// The pixels are read back from the GPU, so an OpenGL context is needed.
+//
+// 	texture: Texture to register
+func (this *SoftwareTarget) RegisterGPUTexture(texture *Texture) {
+	this.RegisterTexture(texture, texture.CopyToImage(), texture.IsSmooth(), texture.IsRepeated())
+}
+
+// Forget the pixels registered for a texture
+func (this *SoftwareTarget) UnregisterTexture(texture *Texture) {
+	delete(this.textures, texture)
+}
+
+// Clear the whole target with a single color
+func (this *SoftwareTarget) Clear(color Color) {
+	pix := this.pixels.pix
+	for i := 0; i < len(pix); i += 4 {
+		pix[i], pix[i+1], pix[i+2], pix[i+3] = color.R, color.G, color.B, color.A
+	}
+}
+
+// Does nothing, the pixels are always up to date
+func (this *SoftwareTarget) Display() {}
+
+// Change the current active view of the target
+//
+// As with SFML, the view is copied: later changes to it
+// are ignored until it is set again.
+//
+// 	view: New view, nil restores the default view
+func (this *SoftwareTarget) SetView(view *View) {
+	if view == nil {
+		view = this.defaultView
+	}
+	this.view = view.Copy()
+}
+
+// Get the current active view of the target
+func (this *SoftwareTarget) GetView() *View {
+	return this.view
+}
+
+// Get the default view of the target
+func (this *SoftwareTarget) GetDefaultView() *View {
+	return this.defaultView
+}
+
+// Get the viewport of a view applied to this target
+//
+// 	view: Target view, nil for the current view
+func (this *SoftwareTarget) GetViewport(view *View) IntRect {
+	return viewportOnTarget(this.GetSize(), this.viewOrCurrent(view))
+}
+
+// Convert a point from target coordinates to world coordinates
+//
+// 	point: Pixel to convert
+// 	view:  The view to use for converting the point, nil for the current view
+func (this *SoftwareTarget) MapPixelToCoords(point Vector2i, view *View) Vector2f {
+	return mapPixelToCoordsOnTarget(this.GetSize(), point, this.viewOrCurrent(view))
+}
+
+// Convert a point from world coordinates to target coordinates
+//
+// 	point: Point to convert
+// 	view:  The view to use for converting the point, nil for the current view
+func (this *SoftwareTarget) MapCoordsToPixel(point Vector2f, view *View) Vector2i {
+	return mapCoordsToPixelOnTarget(this.GetSize(), point, this.viewOrCurrent(view))
+}
+
+// Does nothing, there are no OpenGL states
+func (this *SoftwareTarget) PushGLStates() {}
+
+// Does nothing, there are no OpenGL states
+func (this *SoftwareTarget) PopGLStates() {}
+
+// Does nothing, there are no OpenGL states
+func (this *SoftwareTarget) ResetGLStates() {}
+
+// Get the size of the target, in pixels
+func (this *SoftwareTarget) GetSize() Vector2u {
+	return Vector2u{uint(this.pixels.width), uint(this.pixels.height)}
+}
+
+// Draw a drawer on the target
+func (this *SoftwareTarget) Draw(drawer Drawer, renderStates RenderStates) {
+	if drawer == nil {
+		return
+	}
+	drawer.Draw(this, renderStates)
+}
+
+// Rasterize primitives defined by a slice of vertices
+func (this *SoftwareTarget) DrawPrimitives(vertices []Vertex, primType PrimitiveType, renderStates RenderStates) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	// local coordinates -> world -> normalized device coordinates -> pixels
+	viewport := this.GetViewport(this.view)
+	transform := Transform{
+		float32(viewport.Width) / 2, 0, float32(viewport.Left) + float32(viewport.Width)/2,
+		0, -float32(viewport.Height) / 2, float32(viewport.Top) + float32(viewport.Height)/2,
+		0, 0, 1,
+	}
+	viewTransform := this.view.GetTransform()
+	transform.Combine(&viewTransform)
+	transform.Combine(&renderStates.Transform)
+
+	projected := make([]rasterVertex, len(vertices))
+	for i, vertex := range vertices {
+		position := transform.TransformPoint(vertex.Position)
+		projected[i] = rasterVertex{
+			x: position.X, y: position.Y,
+			color: [4]float32{
+				float32(vertex.Color.R) / 255, float32(vertex.Color.G) / 255,
+				float32(vertex.Color.B) / 255, float32(vertex.Color.A) / 255,
+			},
+			u: vertex.TexCoords.X, v: vertex.TexCoords.Y,
+		}
+	}
+
+	ok, clip := viewport.Intersects(IntRect{0, 0, this.pixels.width, this.pixels.height})
+	if !ok {
+		return
+	}
+
+	raster := &rasterizer{
+		pixels:  this.pixels,
+		clip:    clip,
+		texture: this.textures[renderStates.Texture],
+		blend:   renderStates.BlendMode,
+	}
+
+	switch primType {
+	case PrimitivePoints:
+		for _, vertex := range projected {
+			raster.point(vertex)
+		}
+	case PrimitiveLines:
+		for i := 0; i+1 < len(projected); i += 2 {
+			raster.line(projected[i], projected[i+1])
+		}
+	case PrimitiveLinesStrip:
+		for i := 0; i+1 < len(projected); i++ {
+			raster.line(projected[i], projected[i+1])
+		}
+	case PrimitiveTriangles:
+		for i := 0; i+2 < len(projected); i += 3 {
+			raster.triangle(projected[i], projected[i+1], projected[i+2])
+		}
+	case PrimitiveTrianglesStrip:
+		for i := 0; i+2 < len(projected); i++ {
+			raster.triangle(projected[i], projected[i+1], projected[i+2])
+		}
+	case PrimitiveTrianglesFan:
+		for i := 1; i+1 < len(projected); i++ {
+			raster.triangle(projected[0], projected[i], projected[i+1])
+		}
+	case PrimitiveQuads:
+		for i := 0; i+3 < len(projected); i += 4 {
+			raster.triangle(projected[i], projected[i+1], projected[i+2])
+			raster.triangle(projected[i], projected[i+2], projected[i+3])
+		}
+	}
+}
+
+// Copy the contents of the target to a new image
+func (this *SoftwareTarget) CopyToImage() *Image {
+	return this.pixels.toImage()
+}
+
+// Get the pixels of the target
+//
+// The returned slice is the buffer of the target itself, in RGBA
+// order, row by row from the top-left corner. It is modified by
+// later draws.
+func (this *SoftwareTarget) GetPixelData() []byte {
+	return this.pixels.pix
+}
+
+// Return the bounds of the target, for image.Image
+func (this *SoftwareTarget) Bounds() image.Rectangle {
+	return image.Rect(0, 0, this.pixels.width, this.pixels.height)
+}
+
+// Return the color model of the target, for image.Image
+func (this *SoftwareTarget) ColorModel() color.Model {
+	return ColorModel
+}
+
+// Return the color of a pixel, for image.Image
+func (this *SoftwareTarget) At(x, y int) color.Color {
+	if x < 0 || y < 0 || x >= this.pixels.width || y >= this.pixels.height {
+		return Color{}
+	}
+	i := (y*this.pixels.width + x) * 4
+	pix := this.pixels.pix
+	return Color{pix[i], pix[i+1], pix[i+2], pix[i+3]}
+}
+
+func (this *SoftwareTarget) viewOrCurrent(view *View) *View {
+	if view == nil {
+		return this.view
+	}
+	return view
+}
+
+/////////////////////////////////////
+///		RASTERIZER
+/////////////////////////////////////
+
+// State of a single DrawPrimitives call
+type rasterizer struct {
+	pixels  *pixelBuffer
+	clip    IntRect
+	texture *softwareTexture
+	blend   BlendMode
+}
+
+// Draw a point covering the pixel it falls in
+func (this *rasterizer) point(vertex rasterVertex) {
+	this.fragment(int(math.Floor(float64(vertex.x))), int(math.Floor(float64(vertex.y))), vertex.color, vertex.u, vertex.v)
+}
+
+// Draw a one pixel wide line, excluding its last pixel as OpenGL does
+func (this *rasterizer) line(from, to rasterVertex) {
+	//only step over the part of the segment inside the clip rectangle,
+	//so that far away or huge lines cost nothing
+	x, y := float64(from.x), float64(from.y)
+	dx, dy := float64(to.x)-x, float64(to.y)-y
+	t0, t1, ok := this.clipSegment(x, y, dx, dy)
+	if !ok {
+		return
+	}
+	//the positions are computed in float64, float32 would lose whole
+	//pixels on segments far longer than the target
+	clipped := func(t float64) rasterVertex {
+		vertex := lerpRasterVertex(from, to, float32(t))
+		vertex.x, vertex.y = float32(x+dx*t), float32(y+dy*t)
+		return vertex
+	}
+	from, to = clipped(t0), clipped(t1)
+
+	//step from pixel center to pixel center along the major axis, the
+	//centers in [from, to) are drawn as with OpenGL's diamond-exit rule
+	major, minor := float64(from.x), float64(from.y)
+	majorDelta, minorDelta := float64(to.x)-major, float64(to.y)-minor
+	xMajor := math.Abs(majorDelta) >= math.Abs(minorDelta)
+	if !xMajor {
+		major, minor = minor, major
+		majorDelta, minorDelta = minorDelta, majorDelta
+	}
+	if majorDelta == 0 {
+		return
+	}
+
+	first, last, step := int(math.Ceil(major-0.5)), int(math.Ceil(major+majorDelta-0.5))-1, 1
+	if majorDelta < 0 {
+		first, last, step = int(math.Floor(major-0.5)), int(math.Floor(major+majorDelta-0.5))+1, -1
+	}
+
+	for i := first; (i-last)*step <= 0; i += step {
+		t := (float64(i) + 0.5 - major) / majorDelta
+		vertex := lerpRasterVertex(from, to, float32(t))
+		j := int(math.Floor(minor + minorDelta*t))
+		if xMajor {
+			this.fragment(i, j, vertex.color, vertex.u, vertex.v)
+		} else {
+			this.fragment(j, i, vertex.color, vertex.u, vertex.v)
+		}
+	}
+}
+
+// Clip the segment from (x, y) along (dx, dy) to the clip rectangle
+// (Liang-Barsky), giving the range of the segment parameter inside it
+func (this *rasterizer) clipSegment(x, y, dx, dy float64) (t0, t1 float64, ok bool) {
+	left, top := float64(this.clip.Left), float64(this.clip.Top)
+	right, bottom := left+float64(this.clip.Width), top+float64(this.clip.Height)
+
+	p := [4]float64{-dx, dx, -dy, dy}
+	q := [4]float64{x - left, right - x, y - top, bottom - y}
+
+	t0, t1 = 0, 1
+	for i := range p {
+		if p[i] == 0 {
+			//parallel to this edge, either entirely in or out
+			if q[i] < 0 {
+				return 0, 0, false
+			}
+			continue
+		}
+		t := q[i] / p[i]
+		if p[i] < 0 {
+			t0 = math.Max(t0, t)
+		} else {
+			t1 = math.Min(t1, t)
+		}
+	}
+	return t0, t1, t0 <= t1
+}
+
+// Fill a triangle, sampling at pixel centers with the top-left rule
+func (this *rasterizer) triangle(v0, v1, v2 rasterVertex) {
+	area := edgeFunction(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		v1, v2 = v2, v1
+		area = -area
+	}
+
+	minX := maxInt(this.clip.Left, int(math.Floor(float64(min32(v0.x, min32(v1.x, v2.x))))))
+	minY := maxInt(this.clip.Top, int(math.Floor(float64(min32(v0.y, min32(v1.y, v2.y))))))
+	maxX := minInt(this.clip.Left+this.clip.Width-1, int(math.Ceil(float64(max32(v0.x, max32(v1.x, v2.x))))))
+	maxY := minInt(this.clip.Top+this.clip.Height-1, int(math.Ceil(float64(max32(v0.y, max32(v1.y, v2.y))))))
+
+	topLeft0, topLeft1, topLeft2 := isTopLeft(v1, v2), isTopLeft(v2, v0), isTopLeft(v0, v1)
+
+	for y := minY; y <= maxY; y++ {
+		py := float32(y) + 0.5
+		for x := minX; x <= maxX; x++ {
+			px := float32(x) + 0.5
+
+			w0 := edgeFunction(v1, v2, px, py)
+			w1 := edgeFunction(v2, v0, px, py)
+			w2 := edgeFunction(v0, v1, px, py)
+			if w0 < 0 || w1 < 0 || w2 < 0 ||
+				(w0 == 0 && !topLeft0) || (w1 == 0 && !topLeft1) || (w2 == 0 && !topLeft2) {
+				continue
+			}
+
+			b0, b1, b2 := w0/area, w1/area, w2/area
+			var color [4]float32
+			for i := range color {
+				color[i] = b0*v0.color[i] + b1*v1.color[i] + b2*v2.color[i]
+			}
+			u := b0*v0.u + b1*v1.u + b2*v2.u
+			v := b0*v0.v + b1*v1.v + b2*v2.v
+
+			this.fragment(x, y, color, u, v)
+		}
+	}
+}
+
+// Shade a pixel and blend it into the target
+func (this *rasterizer) fragment(x, y int, color [4]float32, u, v float32) {
+	if x < this.clip.Left || y < this.clip.Top || x >= this.clip.Left+this.clip.Width || y >= this.clip.Top+this.clip.Height {
+		return
+	}
+
+	if this.texture != nil {
+		texel := this.texture.sample(u, v)
+		for i := range color {
+			color[i] *= texel[i]
+		}
+	}
+
+	i := (y*this.pixels.width + x) * 4
+	pix := this.pixels.pix[i : i+4]
+	dst := [4]float32{float32(pix[0]) / 255, float32(pix[1]) / 255, float32(pix[2]) / 255, float32(pix[3]) / 255}
+
+	for c := 0; c < 4; c++ {
+		srcFactor, dstFactor, equation := this.blend.ColorSrcFactor, this.blend.ColorDstFactor, this.blend.ColorEquation
+		if c == 3 {
+			srcFactor, dstFactor, equation = this.blend.AlphaSrcFactor, this.blend.AlphaDstFactor, this.blend.AlphaEquation
+		}
+
+		s := color[c] * blendFactor(srcFactor, color, dst, c)
+		d := dst[c] * blendFactor(dstFactor, color, dst, c)
+		result := s + d
+		if equation == EquationSubtract {
+			result = s - d
+		}
+		pix[c] = unitToByte(result)
+	}
+}
+
+// Sample a texture at pixel coordinates, returning RGBA in [0, 1]
+func (this *softwareTexture) sample(u, v float32) [4]float32 {
+	if !this.smooth {
+		return this.texel(int(math.Floor(float64(u))), int(math.Floor(float64(v))))
+	}
+
+	// bilinear filtering between the four nearest texel centers
+	fu, fv := float64(u)-0.5, float64(v)-0.5
+	x0, y0 := int(math.Floor(fu)), int(math.Floor(fv))
+	tx, ty := float32(fu-math.Floor(fu)), float32(fv-math.Floor(fv))
+
+	c00, c10 := this.texel(x0, y0), this.texel(x0+1, y0)
+	c01, c11 := this.texel(x0, y0+1), this.texel(x0+1, y0+1)
+
+	var result [4]float32
+	for i := range result {
+		top := c00[i] + (c10[i]-c00[i])*tx
+		bottom := c01[i] + (c11[i]-c01[i])*tx
+		result[i] = top + (bottom-top)*ty
+	}
+	return result
+}
+
+// Fetch a texel, wrapping or clamping the coordinates
+func (this *softwareTexture) texel(x, y int) [4]float32 {
+	width, height := this.pixels.width, this.pixels.height
+	if width == 0 || height == 0 {
+		return [4]float32{1, 1, 1, 1}
+	}
+
+	if this.repeated {
+		x, y = ((x%width)+width)%width, ((y%height)+height)%height
+	} else {
+		x, y = maxInt(0, minInt(x, width-1)), maxInt(0, minInt(y, height-1))
+	}
+
+	i := (y*width + x) * 4
+	pix := this.pixels.pix
+	return [4]float32{float32(pix[i]) / 255, float32(pix[i+1]) / 255, float32(pix[i+2]) / 255, float32(pix[i+3]) / 255}
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Twice the signed area of the triangle (a, b, p), positive if p is on the right of a->b
+func edgeFunction(a, b rasterVertex, px, py float32) float32 {
+	return (b.x-a.x)*(py-a.y) - (b.y-a.y)*(px-a.x)
+}
+
+// Tell whether the edge a->b is a top or a left edge of a triangle with positive area
+func isTopLeft(a, b rasterVertex) bool {
+	dx, dy := b.x-a.x, b.y-a.y
+	return (dy == 0 && dx > 0) || dy < 0
+}
+
+func lerpRasterVertex(a, b rasterVertex, t float32) (result rasterVertex) {
+	result.x = a.x + (b.x-a.x)*t
+	result.y = a.y + (b.y-a.y)*t
+	for i := range result.color {
+		result.color[i] = a.color[i] + (b.color[i]-a.color[i])*t
+	}
+	result.u = a.u + (b.u-a.u)*t
+	result.v = a.v + (b.v-a.v)*t
+	return
+}
+
+// Value of a blend factor for one channel, colors in [0, 1]
+func blendFactor(factor BlendFactor, src, dst [4]float32, channel int) float32 {
+	switch factor {
+	case FactorZero:
+		return 0
+	case FactorOne:
+		return 1
+	case FactorSrcColor:
+		return src[channel]
+	case FactorOneMinusSrcColor:
+		return 1 - src[channel]
+	case FactorDstColor:
+		return dst[channel]
+	case FactorOneMinusDstColor:
+		return 1 - dst[channel]
+	case FactorSrcAlpha:
+		return src[3]
+	case FactorOneMinusSrcAlpha:
+		return 1 - src[3]
+	case FactorDstAlpha:
+		return dst[3]
+	case FactorOneMinusDstAlpha:
+		return 1 - dst[3]
+	}
+	return 0
+}
+
+/////////////////////////////////////
+///		TEST
+/////////////////////////////////////
+
+var _ RenderTarget = (*SoftwareTarget)(nil)
+var _ image.Image = (*SoftwareTarget)(nil)
@@ -0,0 +1,189 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"math"
+	"testing"
+)
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func newTestRasterizer(width, height int) *rasterizer {
+	return &rasterizer{
+		pixels: newPixelBuffer(width, height),
+		clip:   IntRect{0, 0, width, height},
+		blend:  BlendAlpha,
+	}
+}
+
+func testRasterVertex(x, y float32, alpha float32) rasterVertex {
+	return rasterVertex{x: x, y: y, color: [4]float32{1, 1, 1, alpha}}
+}
+
+func pixelAt(pixels *pixelBuffer, x, y int) Color {
+	i := (y*pixels.width + x) * 4
+	return Color{pixels.pix[i], pixels.pix[i+1], pixels.pix[i+2], pixels.pix[i+3]}
+}
+
+// Check that exactly the pixels for which covered returns true are set to want,
+// all the others must still be transparent black
+func assertCoverage(t *testing.T, name string, pixels *pixelBuffer, want Color, covered func(x, y int) bool) {
+	t.Helper()
+
+	for y := 0; y < pixels.height; y++ {
+		for x := 0; x < pixels.width; x++ {
+			expected := Color{}
+			if covered(x, y) {
+				expected = want
+			}
+			if got := pixelAt(pixels, x, y); got != expected {
+				t.Errorf("%s: pixel (%d, %d) is %v, want %v", name, x, y, got, expected)
+			}
+		}
+	}
+}
+
+/////////////////////////////////////
+///		LINES
+/////////////////////////////////////
+
+func TestRasterizerLine(t *testing.T) {
+	raster := newTestRasterizer(8, 8)
+	raster.line(testRasterVertex(1, 2.5, 1), testRasterVertex(6, 2.5, 1))
+
+	//the pixel of the last point is not drawn
+	assertCoverage(t, "horizontal line", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return y == 2 && x >= 1 && x < 6
+	})
+}
+
+// Lines between pixel centers, as commonly drawn with SFML, must
+// cover the pixel of the first point and stop before the last one
+func TestRasterizerLinePixelCenters(t *testing.T) {
+	raster := newTestRasterizer(8, 8)
+	raster.line(testRasterVertex(1.5, 2.5, 1), testRasterVertex(5.5, 2.5, 1))
+	assertCoverage(t, "left to right", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return y == 2 && x >= 1 && x <= 4
+	})
+
+	raster = newTestRasterizer(8, 8)
+	raster.line(testRasterVertex(5.5, 2.5, 1), testRasterVertex(1.5, 2.5, 1))
+	assertCoverage(t, "right to left", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return y == 2 && x >= 2 && x <= 5
+	})
+
+	raster = newTestRasterizer(8, 8)
+	raster.line(testRasterVertex(3.5, 0.5, 1), testRasterVertex(3.5, 6.5, 1))
+	assertCoverage(t, "top to bottom", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return x == 3 && y >= 0 && y <= 5
+	})
+
+	raster = newTestRasterizer(8, 8)
+	raster.line(testRasterVertex(0.5, 0.5, 1), testRasterVertex(6.5, 2.5, 1))
+	rows := []int{0, 0, 1, 1, 1, 2}
+	assertCoverage(t, "shallow slope", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return x < len(rows) && y == rows[x]
+	})
+}
+
+// A segment far longer than the target must be clipped before it is
+// stepped over, or it would take ages to draw
+func TestRasterizerLineClipsHugeSegments(t *testing.T) {
+	raster := newTestRasterizer(16, 8)
+	raster.line(testRasterVertex(-1e9, 3.5, 1), testRasterVertex(1e9, 3.5, 1))
+	raster.line(testRasterVertex(5.5, -1e9, 1), testRasterVertex(5.5, 1e9, 1))
+	assertCoverage(t, "huge lines", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return y == 3 || x == 5
+	})
+
+	raster = newTestRasterizer(16, 8)
+	raster.line(testRasterVertex(-1e9, -1e9, 1), testRasterVertex(1e9, -1e9+1, 1))
+	raster.line(testRasterVertex(-1e9, 20, 1), testRasterVertex(1e9, 20, 1))
+	raster.line(testRasterVertex(float32(math.Inf(-1)), 2.5, 1), testRasterVertex(float32(math.Inf(1)), 2.5, 1))
+	raster.line(testRasterVertex(float32(math.NaN()), 2.5, 1), testRasterVertex(4, 2.5, 1))
+	assertCoverage(t, "lines outside the target", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return false
+	})
+}
+
+func TestRasterizerLineClipRect(t *testing.T) {
+	raster := newTestRasterizer(16, 16)
+	raster.clip = IntRect{4, 4, 8, 8}
+
+	raster.line(testRasterVertex(0, 6.5, 1), testRasterVertex(16, 6.5, 1))
+	raster.line(testRasterVertex(-100, -100, 1), testRasterVertex(100, 100, 1))
+
+	assertCoverage(t, "clipped lines", raster.pixels, ColorWhite(), func(x, y int) bool {
+		inside := x >= 4 && y >= 4 && x < 12 && y < 12
+		return inside && (y == 6 || x == y)
+	})
+}
+
+/////////////////////////////////////
+///		TRIANGLES
+/////////////////////////////////////
+
+// Two triangles sharing an edge must cover each pixel exactly once
+func TestRasterizerTrianglesShareEdges(t *testing.T) {
+	raster := newTestRasterizer(10, 10)
+
+	a, b := testRasterVertex(2, 1, 0.4), testRasterVertex(8, 1, 0.4)
+	c, d := testRasterVertex(8, 7, 0.4), testRasterVertex(2, 7, 0.4)
+	raster.triangle(a, b, c)
+	raster.triangle(a, c, d)
+
+	//blended once over transparent black
+	want := Color{102, 102, 102, 102}
+	assertCoverage(t, "quad", raster.pixels, want, func(x, y int) bool {
+		return x >= 2 && x < 8 && y >= 1 && y < 7
+	})
+}
+
+func TestRasterizerTriangleClipped(t *testing.T) {
+	raster := newTestRasterizer(8, 8)
+	raster.triangle(testRasterVertex(-1e6, -1e6, 1), testRasterVertex(1e6, -1e6, 1), testRasterVertex(0, 1e6, 1))
+
+	assertCoverage(t, "huge triangle", raster.pixels, ColorWhite(), func(x, y int) bool {
+		return true
+	})
+}
+
+/////////////////////////////////////
+///		TARGET
+/////////////////////////////////////
+
+func TestSoftwareTargetDraw(t *testing.T) {
+	target := NewSoftwareTarget(12, 10)
+
+	shape, err := NewRectangleShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape.SetSize(Vector2f{5, 3})
+	shape.SetPosition(Vector2f{4, 2})
+	shape.SetFillColor(ColorRed())
+
+	target.Draw(shape, DefaultRenderStates())
+	target.Draw(nil, DefaultRenderStates())
+
+	assertCoverage(t, "rectangle", target.pixels, ColorRed(), func(x, y int) bool {
+		return x >= 4 && x < 9 && y >= 2 && y < 5
+	})
+
+	//the same lines through the view, one of them crossing the whole world
+	target.Clear(Color{})
+	lines := []Vertex{
+		{Position: Vector2f{-1e7, 7.5}, Color: ColorWhite()},
+		{Position: Vector2f{1e7, 7.5}, Color: ColorWhite()},
+		{Position: Vector2f{1.5, 0}, Color: ColorWhite()},
+		{Position: Vector2f{1.5, 5}, Color: ColorWhite()},
+	}
+	target.DrawPrimitives(lines, PrimitiveLines, DefaultRenderStates())
+
+	assertCoverage(t, "lines", target.pixels, ColorWhite(), func(x, y int) bool {
+		return y == 7 || (x == 1 && y < 5)
+	})
+}
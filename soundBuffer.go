@@ -44,14 +44,18 @@ func NewSoundBufferFromFile(file string) (*SoundBuffer, error) {
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if cptr := C.sfSoundBuffer_createFromFile(cFile); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfSoundBuffer_createFromFile(cFile)
+	message := endErrorCapture()
+
+	if cptr != nil {
 		buffer := &SoundBuffer{cptr}
 		runtime.SetFinalizer(buffer, (*SoundBuffer).destroy)
 
 		return buffer, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("sound buffer", file, message)
 }
 
 // Create a new sound buffer and load it from a file in memory
@@ -66,14 +70,18 @@ func NewSoundBufferFromMemory(data []byte) (*SoundBuffer, error) {
 		return nil, errors.New("NewSoundBufferFromMemory: len(data)==0")
 	}
 
-	if cptr := C.sfSoundBuffer_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data))); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfSoundBuffer_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		buffer := &SoundBuffer{cptr}
 		runtime.SetFinalizer(buffer, (*SoundBuffer).destroy)
 
 		return buffer, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("sound buffer", "", message)
 }
 
 // Create a new sound buffer and load it from an array of samples in memory
@@ -89,13 +97,17 @@ func NewSoundBufferFromSamples(samples []int16, channelCount, sampleRate uint) (
 		return nil, errors.New("NewSoundBufferFromSamples: len(data)==0")
 	}
 
-	if cptr := C.sfSoundBuffer_createFromSamples((*C.sfInt16)(unsafe.Pointer(&samples[0])), C.sfUint64(len(samples)), C.uint(channelCount), C.uint(sampleRate)); cptr != nil {
+	beginErrorCapture()
+	cptr := C.sfSoundBuffer_createFromSamples((*C.sfInt16)(unsafe.Pointer(&samples[0])), C.sfUint64(len(samples)), C.uint(channelCount), C.uint(sampleRate))
+	message := endErrorCapture()
+
+	if cptr != nil {
 		buffer := &SoundBuffer{cptr}
 		runtime.SetFinalizer(buffer, (*SoundBuffer).destroy)
 
 		return buffer, nil
 	}
-	return nil, genericError
+	return nil, newLoadError("sound buffer", "", message)
 }
 
 // Create a new sound buffer by copying an existing one
@@ -121,8 +133,12 @@ func (this *SoundBuffer) SaveToFile(file string) error {
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if !sfBool2Go(C.sfSoundBuffer_saveToFile(this.cptr, cFile)) {
-		return genericError
+	beginErrorCapture()
+	success := sfBool2Go(C.sfSoundBuffer_saveToFile(this.cptr, cFile))
+	message := endErrorCapture()
+
+	if !success {
+		return newSfmlError(message)
 	}
 	return nil
 }
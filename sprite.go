@@ -17,6 +17,10 @@ import "runtime"
 type Sprite struct {
 	cptr    *C.sfSprite
 	texture *Texture //to prevent the GC from deleting the texture
+
+	transformState
+	color       Color
+	textureRect IntRect
 }
 
 /////////////////////////////////////
@@ -26,7 +30,7 @@ type Sprite struct {
 // Create a new sprite with a given texture (can be nil to use no texture)
 func NewSprite(tex *Texture) (*Sprite, error) {
 	if cptr := C.sfSprite_create(); cptr != nil {
-		shape := &Sprite{cptr: cptr}
+		shape := &Sprite{cptr: cptr, transformState: newTransformState(), color: ColorWhite()}
 		runtime.SetFinalizer(shape, (*Sprite).destroy)
 		shape.SetTexture(tex, true)
 
@@ -38,9 +42,12 @@ func NewSprite(tex *Texture) (*Sprite, error) {
 
 // Copy an existing sprite
 func (this *Sprite) Copy() *Sprite {
-	sprite := &Sprite{C.sfSprite_copy(this.cptr), this.texture}
-	runtime.SetFinalizer(sprite, (*Sprite).destroy)
-	return sprite
+	this.flush()
+
+	sprite := *this
+	sprite.cptr = C.sfSprite_copy(this.cptr)
+	runtime.SetFinalizer(&sprite, (*Sprite).destroy)
+	return &sprite
 }
 
 // Destroy an existing sprite
@@ -54,7 +61,7 @@ func (this *Sprite) destroy() {
 // See Sprite.Move to apply an offset based on the previous position instead.
 // The default position of a sprite Sprite object is (0, 0).
 func (this *Sprite) SetPosition(pos Vector2f) {
-	C.sfSprite_setPosition(this.cptr, pos.toC())
+	this.setPosition(pos)
 }
 
 // Set the scale factors of a sprite
@@ -63,7 +70,7 @@ func (this *Sprite) SetPosition(pos Vector2f) {
 // See sfSprite_scale to add a factor based on the previous scale instead.
 // The default scale of a sprite Sprite object is (1, 1).
 func (this *Sprite) SetScale(scale Vector2f) {
-	C.sfSprite_setScale(this.cptr, scale.toC())
+	this.setScale(scale)
 }
 
 // Set the local origin of a sprite
@@ -75,7 +82,7 @@ func (this *Sprite) SetScale(scale Vector2f) {
 // transformations (position, scale, rotation).
 // The default origin of a sprite Sprite object is (0, 0).
 func (this *Sprite) SetOrigin(orig Vector2f) {
-	C.sfSprite_setOrigin(this.cptr, orig.toC())
+	this.setOrigin(orig)
 }
 
 // Set the orientation of a sprite
@@ -84,7 +91,7 @@ func (this *Sprite) SetOrigin(orig Vector2f) {
 // See Sprite.Rotate to add an angle based on the previous rotation instead.
 // The default rotation of a sprite Sprite object is 0.
 func (this *Sprite) SetRotation(rot float32) {
-	C.sfSprite_setRotation(this.cptr, C.float(rot))
+	this.setRotation(rot)
 }
 
 // Move a sprite by a given offset
@@ -92,7 +99,7 @@ func (this *Sprite) SetRotation(rot float32) {
 // This function adds to the current position of the object,
 // unlike Sprite.SetPosition which overwrites it.
 func (this *Sprite) Move(offset Vector2f) {
-	C.sfSprite_move(this.cptr, offset.toC())
+	this.move(offset)
 }
 
 // Scale a sprite
@@ -100,7 +107,7 @@ func (this *Sprite) Move(offset Vector2f) {
 // This function multiplies the current scale of the object,
 // unlike Sprite.SetScale which overwrites it.
 func (this *Sprite) Scale(factor Vector2f) {
-	C.sfSprite_scale(this.cptr, factor.toC())
+	this.scaleBy(factor)
 }
 
 // Rotate a sprite
@@ -108,32 +115,29 @@ func (this *Sprite) Scale(factor Vector2f) {
 // This function adds to the current rotation of the object,
 // unlike Sprite.SetRotation which overwrites it.
 func (this *Sprite) Rotate(angle float32) {
-	C.sfSprite_rotate(this.cptr, C.float(angle))
+	this.rotate(angle)
 }
 
 // Get the orientation of a sprite
 //
 // The rotation is always in the range [0, 360].
 func (this *Sprite) GetRotation() float32 {
-	return float32(C.sfSprite_getRotation(this.cptr))
+	return this.rotation
 }
 
 // Get the position of a sprite
 func (this *Sprite) GetPosition() (pos Vector2f) {
-	pos.fromC(C.sfSprite_getPosition(this.cptr))
-	return
+	return this.position
 }
 
 // Get the current scale of a sprite
 func (this *Sprite) GetScale() (scale Vector2f) {
-	scale.fromC(C.sfSprite_getScale(this.cptr))
-	return
+	return this.scale
 }
 
 // Get the local origin of a sprite
 func (this *Sprite) GetOrigin() (origin Vector2f) {
-	origin.fromC(C.sfSprite_getOrigin(this.cptr))
-	return
+	return this.origin
 }
 
 // Change the source texture of a sprite
@@ -151,8 +155,10 @@ func (this *Sprite) GetOrigin() (origin Vector2f) {
 // 	texture:   New texture
 // 	resetRect: Should the texture rect be reset to the size of the new texture?
 func (this *Sprite) SetTexture(texture *Texture, resetRect bool) {
+	this.flush()
 	C.sfSprite_setTexture(this.cptr, texture.toCPtr(), goBool2C(resetRect))
 	this.texture = texture
+	this.textureRect.fromC(C.sfSprite_getTextureRect(this.cptr))
 }
 
 // Set the sub-rectangle of the texture that a sprite will display
@@ -163,7 +169,8 @@ func (this *Sprite) SetTexture(texture *Texture, resetRect bool) {
 //
 // 	rect: Rectangle defining the region of the texture to display
 func (this *Sprite) SetTextureRect(rect IntRect) {
-	C.sfSprite_setTextureRect(this.cptr, rect.toC())
+	this.textureRect = rect
+	this.dirty |= dirtyTextureRect
 }
 
 // Get the source texture of a sprite
@@ -175,14 +182,12 @@ func (this *Sprite) GetTexture() *Texture {
 
 // Get the sub-rectangle of the texture displayed by a sprite
 func (this *Sprite) GetTextureRect() (rect IntRect) {
-	rect.fromC(C.sfSprite_getTextureRect(this.cptr))
-	return
+	return this.textureRect
 }
 
 // Get the global color of a sprite
 func (this *Sprite) GetColor() (color Color) {
-	color.fromC(C.sfSprite_getColor(this.cptr))
-	return
+	return this.color
 }
 
 // Set the global color of a sprite
@@ -192,19 +197,18 @@ func (this *Sprite) GetColor() (color Color) {
 // its global opacity.
 // By default, the sprite's color is opaque white.
 func (this *Sprite) SetColor(color Color) {
-	C.sfSprite_setColor(this.cptr, color.toC())
+	this.color = color
+	this.dirty |= dirtyColor
 }
 
 // Get the combined transform of a sprite
 func (this *Sprite) GetTransform() (trans Transform) {
-	trans.fromC(C.sfSprite_getTransform(this.cptr))
-	return
+	return this.getTransform()
 }
 
 // Get the inverse of the combined transform of a sprite
 func (this *Sprite) GetInverseTransform() (transform Transform) {
-	transform.fromC(C.sfSprite_getInverseTransform(this.cptr))
-	return
+	return this.getInverseTransform()
 }
 
 // Get the local bounding rectangle of a sprite
@@ -215,8 +219,7 @@ func (this *Sprite) GetInverseTransform() (transform Transform) {
 // In other words, this function returns the bounds of the
 // entity in the entity's coordinate system.
 func (this *Sprite) GetLocalBounds() (rect FloatRect) {
-	rect.fromC(C.sfSprite_getLocalBounds(this.cptr))
-	return
+	return FloatRect{0, 0, float32(absInt(this.textureRect.Width)), float32(absInt(this.textureRect.Height))}
 }
 
 // Get the global bounding rectangle of a sprite
@@ -227,17 +230,53 @@ func (this *Sprite) GetLocalBounds() (rect FloatRect) {
 // In other words, this function returns the bounds of the
 // sprite in the global 2D world's coordinate system.
 func (this *Sprite) GetGlobalBounds() (rect FloatRect) {
-	rect.fromC(C.sfSprite_getGlobalBounds(this.cptr))
-	return
+	transform := this.getTransform()
+	return transform.TransformRect(this.GetLocalBounds())
 }
 
 // Draws a RectangleShape on a render target
+//
+// Other targets receive the geometry SFML would build, through DrawPrimitives.
 func (this *Sprite) Draw(target RenderTarget, renderStates RenderStates) {
+	this.flush()
 	rs := renderStates.toC()
 	switch target.(type) {
 	case *RenderWindow:
 		C.sfRenderWindow_drawSprite(target.(*RenderWindow).cptr, this.cptr, &rs)
 	case *RenderTexture:
 		C.sfRenderTexture_drawSprite(target.(*RenderTexture).cptr, this.cptr, &rs)
+	default:
+		transform := this.getTransform()
+		renderStates.Transform.Combine(&transform)
+		renderStates.Texture = this.texture
+		vertices := spriteGeometry(this.textureRect, this.color)
+		target.DrawPrimitives(vertices[:], PrimitiveTrianglesStrip, renderStates)
+	}
+}
+
+// Send the state changed since the last flush to the C sprite
+func (this *Sprite) flush() {
+	if this.dirty == 0 {
+		return
+	}
+
+	if this.dirty&dirtyPosition != 0 {
+		C.sfSprite_setPosition(this.cptr, this.position.toC())
+	}
+	if this.dirty&dirtyScale != 0 {
+		C.sfSprite_setScale(this.cptr, this.scale.toC())
+	}
+	if this.dirty&dirtyOrigin != 0 {
+		C.sfSprite_setOrigin(this.cptr, this.origin.toC())
+	}
+	if this.dirty&dirtyRotation != 0 {
+		C.sfSprite_setRotation(this.cptr, C.float(this.rotation))
+	}
+	if this.dirty&dirtyColor != 0 {
+		C.sfSprite_setColor(this.cptr, this.color.toC())
+	}
+	if this.dirty&dirtyTextureRect != 0 {
+		C.sfSprite_setTextureRect(this.cptr, this.textureRect.toC())
 	}
+	this.dirty = 0
 }
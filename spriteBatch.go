@@ -0,0 +1,261 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "sort"
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+type SpriteSortMode int
+
+const (
+	SpriteSortNone    SpriteSortMode = iota ///< Draw in submission order
+	SpriteSortTexture                       ///< Group sprites by texture, keeping the submission order within a texture
+	SpriteSortDepth                         ///< Draw by increasing depth, sprites of equal depth are grouped by texture
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// BatchSprite is a lightweight sprite drawn through a SpriteBatch
+//
+// It is a plain value without a C object. The zero value has a
+// zero scale and a transparent color, start from NewBatchSprite
+// to get the defaults of Sprite.
+type BatchSprite struct {
+	Texture     *Texture
+	TextureRect IntRect
+	Position    Vector2f
+	Scale       Vector2f
+	Origin      Vector2f
+	Rotation    float32 // Rotation, in degrees
+	Color       Color
+	Depth       float32 // Depth used by SpriteSortDepth
+}
+
+// SpriteBatch accumulates sprites into a vertex buffer and draws
+// them with as few DrawPrimitives calls as possible
+//
+// Sprites are drawn between Begin and End. A new draw call is only
+// issued when the texture, the blend mode or the shader changes
+// from one sprite to the next, so sorting by texture reduces the
+// number of calls further.
+//
+// 	batch.Begin(window, sf.SpriteSortTexture, sf.DefaultRenderStates())
+// 	for _, sprite := range sprites {
+// 		batch.Draw(sprite)
+// 	}
+// 	batch.End()
+type SpriteBatch struct {
+	target       RenderTarget
+	renderStates RenderStates
+	sortMode     SpriteSortMode
+	begun        bool
+
+	items     []batchItem
+	groups    map[*Texture]int // group of each texture in the current batch
+	vertices  []Vertex         // reused between frames
+	drawCalls int
+}
+
+// Sprite queued in a batch, along with the states it was drawn with
+type batchItem struct {
+	transform   Transform
+	textureRect IntRect
+	color       Color
+	texture     *Texture
+	blendMode   BlendMode
+	shader      *Shader
+	depth       float32
+	group       int // order of the first appearance of the texture
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Create a new batch sprite showing a texture rect
+//
+// The scale is (1, 1) and the color opaque white, as for Sprite.
+//
+// 	texture:     Texture of the sprite, can be nil
+// 	textureRect: Sub-rectangle of the texture to display
+func NewBatchSprite(texture *Texture, textureRect IntRect) BatchSprite {
+	return BatchSprite{Texture: texture, TextureRect: textureRect, Scale: Vector2f{1, 1}, Color: ColorWhite()}
+}
+
+// Get the combined transform of a batch sprite
+func (this *BatchSprite) GetTransform() Transform {
+	return transformableMatrix(this.Position, this.Scale, this.Origin, this.Rotation)
+}
+
+// Get the global bounding rectangle of a batch sprite
+func (this *BatchSprite) GetGlobalBounds() FloatRect {
+	transform := this.GetTransform()
+	return transform.TransformRect(FloatRect{0, 0, float32(absInt(this.TextureRect.Width)), float32(absInt(this.TextureRect.Height))})
+}
+
+// Create a new sprite batch
+func NewSpriteBatch() *SpriteBatch {
+	return &SpriteBatch{groups: make(map[*Texture]int)}
+}
+
+// Start a batch
+//
+// If the previous batch has not been ended, it is ended first.
+//
+// 	target:       Target the sprites are drawn on
+// 	sortMode:     Order in which the sprites are drawn
+// 	renderStates: States for the whole batch, the texture is ignored
+func (this *SpriteBatch) Begin(target RenderTarget, sortMode SpriteSortMode, renderStates RenderStates) {
+	if this.begun {
+		this.End()
+	}
+
+	this.target = target
+	this.sortMode = sortMode
+	this.renderStates = renderStates
+	this.renderStates.Texture = nil
+	this.items = this.items[:0]
+	for texture := range this.groups {
+		delete(this.groups, texture)
+	}
+	this.begun = true
+}
+
+// Change the blend mode of the sprites drawn after this call
+func (this *SpriteBatch) SetBlendMode(blendMode BlendMode) {
+	this.renderStates.BlendMode = blendMode
+}
+
+// Change the shader of the sprites drawn after this call, can be nil
+func (this *SpriteBatch) SetShader(shader *Shader) {
+	this.renderStates.Shader = shader
+}
+
+// Queue a batch sprite
+func (this *SpriteBatch) Draw(sprite BatchSprite) {
+	this.queue(sprite.GetTransform(), sprite.TextureRect, sprite.Color, sprite.Texture, sprite.Depth)
+}
+
+// Queue a sprite
+//
+// The texture, texture rect, color and transform of the sprite
+// are read when it is queued, without any cgo call.
+//
+// 	sprite: Sprite to draw
+// 	depth:  Depth used by SpriteSortDepth
+func (this *SpriteBatch) DrawSprite(sprite *Sprite, depth float32) {
+	this.queue(sprite.getTransform(), sprite.textureRect, sprite.color, sprite.texture, depth)
+}
+
+// Draw the queued sprites and end the batch
+func (this *SpriteBatch) End() {
+	if !this.begun {
+		return
+	}
+	this.begun = false
+	this.drawCalls = 0
+
+	switch this.sortMode {
+	case SpriteSortTexture:
+		sort.SliceStable(this.items, func(i, j int) bool {
+			return this.items[i].group < this.items[j].group
+		})
+	case SpriteSortDepth:
+		sort.SliceStable(this.items, func(i, j int) bool {
+			a, b := &this.items[i], &this.items[j]
+			if a.depth != b.depth {
+				return a.depth < b.depth
+			}
+			return a.group < b.group
+		})
+	}
+
+	this.vertices = this.vertices[:0]
+	for i := range this.items {
+		item := &this.items[i]
+		if i > 0 && !item.sameStates(&this.items[i-1]) {
+			this.flush(&this.items[i-1])
+		}
+		this.vertices = appendBatchQuad(this.vertices, item)
+	}
+	if len(this.items) > 0 {
+		this.flush(&this.items[len(this.items)-1])
+	}
+
+	this.items = this.items[:0]
+	this.target = nil
+}
+
+// Get the number of DrawPrimitives calls made by the last End
+func (this *SpriteBatch) GetDrawCalls() int {
+	return this.drawCalls
+}
+
+// Get the number of sprites queued since Begin
+func (this *SpriteBatch) GetSpriteCount() int {
+	return len(this.items)
+}
+
+func (this *SpriteBatch) queue(transform Transform, textureRect IntRect, color Color, texture *Texture, depth float32) {
+	if !this.begun {
+		panic("SpriteBatch: Draw called before Begin")
+	}
+
+	// textures are grouped by first appearance, which keeps sorting deterministic
+	group, ok := this.groups[texture]
+	if !ok {
+		group = len(this.groups)
+		this.groups[texture] = group
+	}
+
+	this.items = append(this.items, batchItem{
+		transform:   transform,
+		textureRect: textureRect,
+		color:       color,
+		texture:     texture,
+		blendMode:   this.renderStates.BlendMode,
+		shader:      this.renderStates.Shader,
+		depth:       depth,
+		group:       group,
+	})
+}
+
+// Draw the pending vertices with the states of item
+func (this *SpriteBatch) flush(item *batchItem) {
+	if len(this.vertices) == 0 {
+		return
+	}
+
+	renderStates := this.renderStates
+	renderStates.Texture = item.texture
+	renderStates.BlendMode = item.blendMode
+	renderStates.Shader = item.shader
+
+	this.target.DrawPrimitives(this.vertices, PrimitiveTriangles, renderStates)
+	this.drawCalls++
+	this.vertices = this.vertices[:0]
+}
+
+func (this *batchItem) sameStates(other *batchItem) bool {
+	return this.texture == other.texture && this.blendMode == other.blendMode && this.shader == other.shader
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Append the two triangles of a sprite, in world coordinates
+func appendBatchQuad(vertices []Vertex, item *batchItem) []Vertex {
+	quad := spriteGeometry(item.textureRect, item.color)
+	for i := range quad {
+		quad[i].Position = item.transform.TransformPoint(quad[i].Position)
+	}
+
+	// the strip order of spriteGeometry is top-left, bottom-left, top-right, bottom-right
+	return append(vertices, quad[0], quad[1], quad[2], quad[2], quad[1], quad[3])
+}
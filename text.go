@@ -36,6 +36,11 @@ type TextStyle int
 type Text struct {
 	cptr *C.sfText
 	font *Font
+
+	transformState
+	color         Color
+	localBounds   FloatRect
+	boundsUpdated bool
 }
 
 /////////////////////////////////////
@@ -45,7 +50,7 @@ type Text struct {
 // Create a new text with a given font (can be nil).
 func NewText(font *Font) (*Text, error) {
 	if cptr := C.sfText_create(); cptr != nil {
-		text := &Text{cptr: cptr}
+		text := &Text{cptr: cptr, transformState: newTransformState(), color: ColorWhite()}
 		runtime.SetFinalizer(text, (*Text).destroy)
 		text.SetFont(font)
 
@@ -62,9 +67,12 @@ func (this *Text) destroy() {
 
 // Copy an existing text
 func (this *Text) Copy() *Text {
-	text := &Text{C.sfText_copy(this.cptr), this.font}
-	runtime.SetFinalizer(text, (*Text).destroy)
-	return text
+	this.flush()
+
+	text := *this
+	text.cptr = C.sfText_copy(this.cptr)
+	runtime.SetFinalizer(&text, (*Text).destroy)
+	return &text
 }
 
 // Set the position of a text
@@ -75,7 +83,7 @@ func (this *Text) Copy() *Text {
 //
 // 	position: New position
 func (this *Text) SetPosition(pos Vector2f) {
-	C.sfText_setPosition(this.cptr, pos.toC())
+	this.setPosition(pos)
 }
 
 // Set the scale factors of a text
@@ -86,7 +94,7 @@ func (this *Text) SetPosition(pos Vector2f) {
 //
 // 	scale: New scale factors
 func (this *Text) SetScale(scale Vector2f) {
-	C.sfText_setScale(this.cptr, scale.toC())
+	this.setScale(scale)
 }
 
 // Set the local origin of a text
@@ -100,7 +108,7 @@ func (this *Text) SetScale(scale Vector2f) {
 //
 // 	origin: New origin
 func (this *Text) SetOrigin(orig Vector2f) {
-	C.sfText_setOrigin(this.cptr, orig.toC())
+	this.setOrigin(orig)
 }
 
 // Set the orientation of a text
@@ -111,7 +119,7 @@ func (this *Text) SetOrigin(orig Vector2f) {
 //
 // 	rot: New rotation, in degrees
 func (this *Text) SetRotation(rot float32) {
-	C.sfText_setRotation(this.cptr, C.float(rot))
+	this.setRotation(rot)
 }
 
 // Move a text by a given offset
@@ -121,7 +129,7 @@ func (this *Text) SetRotation(rot float32) {
 //
 // 	offset: Offset
 func (this *Text) Move(offset Vector2f) {
-	C.sfText_move(this.cptr, offset.toC())
+	this.move(offset)
 }
 
 // Scale a text
@@ -131,7 +139,7 @@ func (this *Text) Move(offset Vector2f) {
 //
 // 	factor: Scale factors
 func (this *Text) Scale(factor Vector2f) {
-	C.sfText_scale(this.cptr, factor.toC())
+	this.scaleBy(factor)
 }
 
 // Rotate a text
@@ -141,56 +149,53 @@ func (this *Text) Scale(factor Vector2f) {
 //
 // 	angle: Angle of rotation, in degrees
 func (this *Text) Rotate(angle float32) {
-	C.sfText_rotate(this.cptr, C.float(angle))
+	this.rotate(angle)
 }
 
 // Get the orientation of a text
 //
 // The rotation is always in the range [0, 360].
 func (this *Text) GetRotation() float32 {
-	return float32(C.sfText_getRotation(this.cptr))
+	return this.rotation
 }
 
 // Get the position of a text
 func (this *Text) GetPosition() (pos Vector2f) {
-	pos.fromC(C.sfText_getPosition(this.cptr))
-	return
+	return this.position
 }
 
 // Get the current scale of a text
 func (this *Text) GetScale() (scale Vector2f) {
-	scale.fromC(C.sfText_getScale(this.cptr))
-	return
+	return this.scale
 }
 
 // Get the local origin of a text
 func (this *Text) GetOrigin() (origin Vector2f) {
-	origin.fromC(C.sfText_getOrigin(this.cptr))
-	return
+	return this.origin
 }
 
 // Get the combined transform of a text
 func (this *Text) GetTransform() (trans Transform) {
-	trans.fromC(C.sfText_getTransform(this.cptr))
-	return
+	return this.getTransform()
 }
 
 // Get the inverse of the combined transform of a text
 func (this *Text) GetInverseTransform() (transform Transform) {
-	transform.fromC(C.sfText_getInverseTransform(this.cptr))
-	return
+	return this.getInverseTransform()
 }
 
 // Set the string of a text (from a unicode string)
 func (this *Text) SetString(text string) {
 	runes := strToRunes(text)
 	C.sfText_setUnicodeString(this.cptr, (*C.sfUint32)(unsafe.Pointer(&runes[0])))
+	this.boundsUpdated = false
 }
 
 // Set the font of a text
 func (this *Text) SetFont(font *Font) {
 	C.sfText_setFont(this.cptr, font.toCPtr())
 	this.font = font
+	this.boundsUpdated = false
 }
 
 // Set the character size of a text
@@ -198,6 +203,7 @@ func (this *Text) SetFont(font *Font) {
 // The default size is 30.
 func (this *Text) SetCharacterSize(size uint) {
 	C.sfText_setCharacterSize(this.cptr, C.uint(size))
+	this.boundsUpdated = false
 }
 
 // Set the style of a text
@@ -207,13 +213,15 @@ func (this *Text) SetCharacterSize(size uint) {
 // The default style is TextRegular.
 func (this *Text) SetStyle(style TextStyle) {
 	C.sfText_setStyle(this.cptr, C.sfUint32(style))
+	this.boundsUpdated = false
 }
 
 // Set the global color of a text
 //
 // By default, the text's color is opaque white.
 func (this *Text) SetColor(color Color) {
-	C.sfText_setColor(this.cptr, color.toC())
+	this.color = color
+	this.dirty |= dirtyColor
 }
 
 // Get the string of a text (returns a unicode string)
@@ -241,8 +249,7 @@ func (this *Text) GetStyle() TextStyle {
 
 // Get the global color of a text
 func (this *Text) GetColor() (color Color) {
-	color.fromC(C.sfText_getColor(this.cptr))
-	return
+	return this.color
 }
 
 // Return the position of the index-th character in a text
@@ -254,6 +261,7 @@ func (this *Text) GetColor() (color Color) {
 // If index is out of range, the position of the end of
 // the string is returned.
 func (this *Text) FindCharacterPos(index uint) (pos Vector2f) {
+	this.flush()
 	pos.fromC(C.sfText_findCharacterPos(this.cptr, C.size_t(index)))
 	return
 }
@@ -265,9 +273,14 @@ func (this *Text) FindCharacterPos(index uint) (pos Vector2f) {
 // scale, ...) that are applied to the entity.
 // In other words, this function returns the bounds of the
 // entity in the entity's coordinate system.
+// The bounds are computed by SFML once and then kept until the
+// string, font, character size or style changes.
 func (this *Text) GetLocalBounds() (rect FloatRect) {
-	rect.fromC(C.sfText_getLocalBounds(this.cptr))
-	return
+	if !this.boundsUpdated {
+		this.localBounds.fromC(C.sfText_getLocalBounds(this.cptr))
+		this.boundsUpdated = true
+	}
+	return this.localBounds
 }
 
 // Get the global bounding rectangle of a text
@@ -278,12 +291,16 @@ func (this *Text) GetLocalBounds() (rect FloatRect) {
 // In other words, this function returns the bounds of the
 // text in the global 2D world's coordinate system.
 func (this *Text) GetGlobalBounds() (rect FloatRect) {
-	rect.fromC(C.sfText_getGlobalBounds(this.cptr))
-	return
+	transform := this.getTransform()
+	return transform.TransformRect(this.GetLocalBounds())
 }
 
 // Draws a Text on a render target
+//
+// Glyphs live in the OpenGL texture of the font, so text is only
+// rendered on RenderWindow and RenderTexture.
 func (this *Text) Draw(target RenderTarget, renderStates RenderStates) {
+	this.flush()
 	rs := renderStates.toC()
 	switch target.(type) {
 	case *RenderWindow:
@@ -292,3 +309,27 @@ func (this *Text) Draw(target RenderTarget, renderStates RenderStates) {
 		C.sfRenderTexture_drawText(target.(*RenderTexture).cptr, this.cptr, &rs)
 	}
 }
+
+// Send the state changed since the last flush to the C text
+func (this *Text) flush() {
+	if this.dirty == 0 {
+		return
+	}
+
+	if this.dirty&dirtyPosition != 0 {
+		C.sfText_setPosition(this.cptr, this.position.toC())
+	}
+	if this.dirty&dirtyScale != 0 {
+		C.sfText_setScale(this.cptr, this.scale.toC())
+	}
+	if this.dirty&dirtyOrigin != 0 {
+		C.sfText_setOrigin(this.cptr, this.origin.toC())
+	}
+	if this.dirty&dirtyRotation != 0 {
+		C.sfText_setRotation(this.cptr, C.float(this.rotation))
+	}
+	if this.dirty&dirtyColor != 0 {
+		C.sfText_setColor(this.cptr, this.color.toC())
+	}
+	this.dirty = 0
+}
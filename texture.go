@@ -31,14 +31,29 @@ type Texture struct {
 // 	width:  Texture width
 // 	height: Texture height
 func NewTexture(width, height uint) (*Texture, error) {
-	if cptr := C.sfTexture_create(C.uint(width), C.uint(height)); cptr != nil {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
+	var (
+		cptr    *C.sfTexture
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfTexture_create(C.uint(width), C.uint(height))
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		texture := &Texture{cptr}
 		runtime.SetFinalizer(texture, (*Texture).destroy)
 
 		return texture, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("texture", "", message)
 }
 
 // Create a new texture from an image
@@ -46,17 +61,32 @@ func NewTexture(width, height uint) (*Texture, error) {
 // 	file: Path of the image file to load
 // 	area: Area of the source image to load (nil to load the entire image)
 func NewTextureFromFile(file string, area *IntRect) (*Texture, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	cFile := C.CString(file)
 	defer C.free(unsafe.Pointer(cFile))
 
-	if cptr := C.sfTexture_createFromFile(cFile, area.toCPtr()); cptr != nil {
+	var (
+		cptr    *C.sfTexture
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfTexture_createFromFile(cFile, area.toCPtr())
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		texture := &Texture{cptr}
 		runtime.SetFinalizer(texture, (*Texture).destroy)
 
 		return texture, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("texture", file, message)
 }
 
 // Create a new texture from a file in memory
@@ -64,18 +94,33 @@ func NewTextureFromFile(file string, area *IntRect) (*Texture, error) {
 // 	data: Slice containing the file data
 // 	area: Area of the source image to load (nil to load the entire image)
 func NewTextureFromMemory(data []byte, area *IntRect) (*Texture, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	if len(data) == 0 {
 		return nil, errors.New("NewTextureFromMemory: len(data)==0")
 	}
 
-	if cptr := C.sfTexture_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)), area.toCPtr()); cptr != nil {
+	var (
+		cptr    *C.sfTexture
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfTexture_createFromMemory(unsafe.Pointer(&data[0]), C.size_t(len(data)), area.toCPtr())
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		texture := &Texture{cptr}
 		runtime.SetFinalizer(texture, (*Texture).destroy)
 
 		return texture, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("texture", "", message)
 }
 
 // Create a new texture from an image
@@ -83,28 +128,51 @@ func NewTextureFromMemory(data []byte, area *IntRect) (*Texture, error) {
 // 	image: Image to upload to the texture
 // 	area:  Area of the source image to load (nil to load the entire image)
 func NewTextureFromImage(image *Image, area *IntRect) (*Texture, error) {
-	if cptr := C.sfTexture_createFromImage(image.toCPtr(), area.toCPtr()); cptr != nil {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
+	var (
+		cptr    *C.sfTexture
+		message string
+	)
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfTexture_createFromImage(image.toCPtr(), area.toCPtr())
+		message = endErrorCapture()
+	})
+
+	if cptr != nil {
 		texture := &Texture{cptr}
 		runtime.SetFinalizer(texture, (*Texture).destroy)
 
 		return texture, nil
 	}
 
-	return nil, genericError
+	return nil, newLoadError("texture", "", message)
 }
 
 // Copy an existing texture
 func (this *Texture) Copy() *Texture {
-	texture := &Texture{C.sfTexture_copy(this.cptr)}
+	var cptr *C.sfTexture
+
+	Call(func() {
+		cptr = C.sfTexture_copy(this.cptr)
+	})
+
+	texture := &Texture{cptr}
 	runtime.SetFinalizer(texture, (*Texture).destroy)
 	return texture
 }
 
 // Destroy an existing texture
 func (this *Texture) destroy() {
-	globalCtxSetActive(true)
-	C.sfTexture_destroy(this.cptr)
-	globalCtxSetActive(false)
+	callAsync(func() {
+		globalCtxSetActive(true)
+		C.sfTexture_destroy(this.cptr)
+		globalCtxSetActive(false)
+	})
 }
 
 // Return the size of the texture
@@ -115,7 +183,13 @@ func (this *Texture) GetSize() (size Vector2u) {
 
 // Copy a texture's pixels to an image
 func (this *Texture) CopyToImage() *Image {
-	return newImageFromPtr(C.sfTexture_copyToImage(this.cptr))
+	var cptr *C.sfImage
+
+	Call(func() {
+		cptr = C.sfTexture_copyToImage(this.cptr)
+	})
+
+	return newImageFromPtr(cptr)
 }
 
 // Update a texture from the contents of a window
@@ -124,7 +198,9 @@ func (this *Texture) CopyToImage() *Image {
 // 	x:       X offset in the texture where to copy the source pixels
 // 	y:       Y offset in the texture where to copy the source pixels
 func (this *Texture) UpdateFromWindow(window *Window, x, y uint) {
-	C.sfTexture_updateFromWindow(this.cptr, window.cptr, C.uint(x), C.uint(y))
+	Call(func() {
+		C.sfTexture_updateFromWindow(this.cptr, window.cptr, C.uint(x), C.uint(y))
+	})
 }
 
 // Update a texture from the contents of a render-window
@@ -133,7 +209,9 @@ func (this *Texture) UpdateFromWindow(window *Window, x, y uint) {
 // 	x:            X offset in the texture where to copy the source pixels
 // 	y:            Y offset in the texture where to copy the source pixels
 func (this *Texture) UpdateFromRenderWindow(window *RenderWindow, x, y uint) {
-	C.sfTexture_updateFromRenderWindow(this.cptr, window.cptr, C.uint(x), C.uint(y))
+	Call(func() {
+		C.sfTexture_updateFromRenderWindow(this.cptr, window.cptr, C.uint(x), C.uint(y))
+	})
 }
 
 // Update a texture from an image
@@ -142,7 +220,9 @@ func (this *Texture) UpdateFromRenderWindow(window *RenderWindow, x, y uint) {
 // 	x:       X offset in the texture where to copy the source pixels
 // 	y:       Y offset in the texture where to copy the source pixels
 func (this *Texture) UpdateFromImage(image *Image, x, y uint) {
-	C.sfTexture_updateFromImage(this.cptr, image.toCPtr(), C.uint(x), C.uint(y))
+	Call(func() {
+		C.sfTexture_updateFromImage(this.cptr, image.toCPtr(), C.uint(x), C.uint(y))
+	})
 }
 
 // Update a texture from an array of pixels
@@ -154,7 +234,9 @@ func (this *Texture) UpdateFromImage(image *Image, x, y uint) {
 // 	y:       Y offset in the texture where to copy the source pixels
 func (this *Texture) UpdateFromPixels(pixels []byte, width, height, x, y uint) {
 	if len(pixels) > 0 {
-		C.sfTexture_updateFromPixels(this.cptr, (*C.sfUint8)(unsafe.Pointer(&pixels[0])), C.uint(width), C.uint(height), C.uint(x), C.uint(y))
+		Call(func() {
+			C.sfTexture_updateFromPixels(this.cptr, (*C.sfUint8)(unsafe.Pointer(&pixels[0])), C.uint(width), C.uint(height), C.uint(x), C.uint(y))
+		})
 	}
 }
 
@@ -167,13 +249,17 @@ func (this *Texture) UpdateFromPixels(pixels []byte, width, height, x, y uint) {
 // 	y:       Y offset in the texture where to copy the source pixels
 func (this *Texture) UpdateFromPixelsUnsafe(pixels unsafe.Pointer, width, height, x, y uint) {
 	if pixels != nil {
-		C.sfTexture_updateFromPixels(this.cptr, (*C.sfUint8)(pixels), C.uint(width), C.uint(height), C.uint(x), C.uint(y))
+		Call(func() {
+			C.sfTexture_updateFromPixels(this.cptr, (*C.sfUint8)(pixels), C.uint(width), C.uint(height), C.uint(x), C.uint(y))
+		})
 	}
 }
 
 // Enable or disable the smooth filter on a texture
 func (this *Texture) SetSmooth(smooth bool) {
-	C.sfTexture_setSmooth(this.cptr, goBool2C(smooth))
+	Call(func() {
+		C.sfTexture_setSmooth(this.cptr, goBool2C(smooth))
+	})
 }
 
 // Tell whether the smooth filter is enabled or not for a texture
@@ -197,7 +283,9 @@ func (this *Texture) IsSmooth() bool {
 // dimensions (such as 256x128).
 // Repeating is disabled by default.
 func (this *Texture) SetRepeated(repeated bool) {
-	C.sfTexture_setRepeated(this.cptr, goBool2C(repeated))
+	Call(func() {
+		C.sfTexture_setRepeated(this.cptr, goBool2C(repeated))
+	})
 }
 
 // Tell whether a texture is repeated or not
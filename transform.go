@@ -7,9 +7,7 @@ package gosfml2
 // #include <SFML/Graphics/Transform.h>
 import "C"
 
-import (
-	"unsafe"
-)
+import "math"
 
 /////////////////////////////////////
 ///		INTERFACES
@@ -47,36 +45,80 @@ func TransformIdentity() Transform { return Transform{1, 0, 0, 0, 1, 0, 0, 0, 1}
 type Transform [9]float32
 type Matrix [16]float32 // 4x4 matrix
 
+// The components of an affine transform, see Transform.Decompose
+type TransformComponents struct {
+	Translation Vector2f // Offset
+	Rotation    float32  // Angle, in degrees
+	Scale       Vector2f // Scaling factors
+	Shear       float32  // Horizontal shear factor, applied before scaling
+}
+
 /////////////////////////////////////
 ///		FUNCS
 /////////////////////////////////////
 
+// Create a transform from a 3x3 matrix
+//
+// The matrix is given row by row.
+func NewTransformFromMatrix(a00, a01, a02, a10, a11, a12, a20, a21, a22 float32) Transform {
+	return Transform{a00, a01, a02, a10, a11, a12, a20, a21, a22}
+}
+
+// Build the transform Translate(Translation).Rotate(Rotation).Scale(Scale).Shear(Shear, 0)
+//
+// This is the inverse of Transform.Decompose.
+func NewTransformFromComponents(components TransformComponents) Transform {
+	trans := TransformIdentity()
+	trans.Translate(components.Translation.X, components.Translation.Y).
+		Rotate(components.Rotation).
+		Scale(components.Scale.X, components.Scale.Y).
+		Shear(components.Shear, 0)
+	return trans
+}
+
 // Return the 4x4 matrix of a transform
 //
 // This function fills an array of 16 floats with the transform
 // converted as a 4x4 matrix, which is directly compatible with
 // OpenGL functions.
-func (this *Transform) GetMatrix() (matrix Matrix) {
-	C.sfTransform_getMatrix(this.toCPtr(), (*C.float)(unsafe.Pointer(&matrix)))
-	return
+func (this *Transform) GetMatrix() Matrix {
+	return Matrix{
+		this[0], this[3], 0, this[6],
+		this[1], this[4], 0, this[7],
+		0, 0, 1, 0,
+		this[2], this[5], 0, this[8],
+	}
 }
 
 // Return the inverse of a transform
 //
 // If the inverse cannot be computed, a new identity transform
 // is returned.
-func (this *Transform) GetInverse() (inverse Transform) {
-	inverse.fromC(C.sfTransform_getInverse(this.toCPtr()))
-	return
+func (this *Transform) GetInverse() Transform {
+	a00, a01, a02 := this[0], this[1], this[2]
+	a10, a11, a12 := this[3], this[4], this[5]
+	a20, a21, a22 := this[6], this[7], this[8]
+
+	det := a00*(a22*a11-a21*a12) - a10*(a22*a01-a21*a02) + a20*(a12*a01-a11*a02)
+	if det == 0 {
+		return TransformIdentity()
+	}
+
+	return Transform{
+		(a22*a11 - a21*a12) / det, -(a22*a01 - a21*a02) / det, (a12*a01 - a11*a02) / det,
+		-(a22*a10 - a20*a12) / det, (a22*a00 - a20*a02) / det, -(a12*a00 - a10*a02) / det,
+		(a21*a10 - a20*a11) / det, -(a21*a00 - a20*a01) / det, (a11*a00 - a10*a01) / det,
+	}
 }
 
 // Apply a transform to a 2D point
 //
 // 	point: Point to transform
-func (this *Transform) TransformPoint(point Vector2f) (tansPoint Vector2f) {
-	vec := C.sfTransform_transformPoint(this.toCPtr(), point.toC())
-	tansPoint.fromC(vec)
-	return
+func (this *Transform) TransformPoint(point Vector2f) Vector2f {
+	return Vector2f{
+		X: this[0]*point.X + this[1]*point.Y + this[2],
+		Y: this[3]*point.X + this[4]*point.Y + this[5],
+	}
 }
 
 // Apply a transform to a rectangle
@@ -88,17 +130,34 @@ func (this *Transform) TransformPoint(point Vector2f) (tansPoint Vector2f) {
 // is returned.
 //
 // 	rect: Rectangle to transform
-func (this *Transform) TransformRect(rect FloatRect) (tansRect FloatRect) {
-	rec := C.sfTransform_transformRect(this.toCPtr(), rect.toC())
-	tansRect.fromC(rec)
-	return
+func (this *Transform) TransformRect(rect FloatRect) FloatRect {
+	points := [4]Vector2f{
+		this.TransformPoint(Vector2f{rect.Left, rect.Top}),
+		this.TransformPoint(Vector2f{rect.Left, rect.Top + rect.Height}),
+		this.TransformPoint(Vector2f{rect.Left + rect.Width, rect.Top}),
+		this.TransformPoint(Vector2f{rect.Left + rect.Width, rect.Top + rect.Height}),
+	}
+
+	min, max := points[0], points[0]
+	for _, point := range points[1:] {
+		min = min.Min(point)
+		max = max.Max(point)
+	}
+
+	return FloatRect{min.X, min.Y, max.X - min.X, max.Y - min.Y}
 }
 
 // Combine two transforms
 //
 // Mathematically, it is equivalent to a matrix multiplication.
 func (this *Transform) Combine(other *Transform) *Transform {
-	C.sfTransform_combine(this.toCPtr(), other.toCPtr())
+	a, b := *this, *other
+
+	*this = Transform{
+		a[0]*b[0] + a[1]*b[3] + a[2]*b[6], a[0]*b[1] + a[1]*b[4] + a[2]*b[7], a[0]*b[2] + a[1]*b[5] + a[2]*b[8],
+		a[3]*b[0] + a[4]*b[3] + a[5]*b[6], a[3]*b[1] + a[4]*b[4] + a[5]*b[7], a[3]*b[2] + a[4]*b[5] + a[5]*b[8],
+		a[6]*b[0] + a[7]*b[3] + a[8]*b[6], a[6]*b[1] + a[7]*b[4] + a[8]*b[7], a[6]*b[2] + a[7]*b[5] + a[8]*b[8],
+	}
 	return this
 }
 
@@ -107,16 +166,24 @@ func (this *Transform) Combine(other *Transform) *Transform {
 // 	x: Offset to apply on X axis
 // 	y: Offset to apply on Y axis
 func (this *Transform) Translate(x, y float32) *Transform {
-	C.sfTransform_translate(this.toCPtr(), C.float(x), C.float(y))
-	return this
+	return this.Combine(&Transform{
+		1, 0, x,
+		0, 1, y,
+		0, 0, 1,
+	})
 }
 
 // Combine the current transform with a rotation
 //
 // 	angle: Rotation angle, in degrees
 func (this *Transform) Rotate(angle float32) *Transform {
-	C.sfTransform_rotate(this.toCPtr(), C.float(angle))
-	return this
+	sin, cos := sinCosDegrees(angle)
+
+	return this.Combine(&Transform{
+		cos, -sin, 0,
+		sin, cos, 0,
+		0, 0, 1,
+	})
 }
 
 // Combine the current transform with a rotation
@@ -130,8 +197,13 @@ func (this *Transform) Rotate(angle float32) *Transform {
 // 	centerX:   X coordinate of the center of rotation
 // 	centerY:   Y coordinate of the center of rotation
 func (this *Transform) RotateWithCenter(angle, centerX, centerY float32) *Transform {
-	C.sfTransform_rotateWithCenter(this.toCPtr(), C.float(angle), C.float(centerX), C.float(centerY))
-	return this
+	sin, cos := sinCosDegrees(angle)
+
+	return this.Combine(&Transform{
+		cos, -sin, centerX*(1-cos) + centerY*sin,
+		sin, cos, centerY*(1-cos) - centerX*sin,
+		0, 0, 1,
+	})
 }
 
 // Combine the current transform with a scaling
@@ -139,8 +211,11 @@ func (this *Transform) RotateWithCenter(angle, centerX, centerY float32) *Transf
 // 	scaleX: Scaling factor on the X axis
 // 	scaleY: Scaling factor on the Y axis
 func (this *Transform) Scale(scaleX, scaleY float32) *Transform {
-	C.sfTransform_scale(this.toCPtr(), C.float(scaleX), C.float(scaleY))
-	return this
+	return this.Combine(&Transform{
+		scaleX, 0, 0,
+		0, scaleY, 0,
+		0, 0, 1,
+	})
 }
 
 // Combine the current transform with a scaling
@@ -155,8 +230,68 @@ func (this *Transform) Scale(scaleX, scaleY float32) *Transform {
 // 	centerX:   X coordinate of the center of scaling
 // 	centerY:   Y coordinate of the center of scaling
 func (this *Transform) ScaleWithCenter(scaleX, scaleY, centerX, centerY float32) *Transform {
-	C.sfTransform_scaleWithCenter(this.toCPtr(), C.float(scaleX), C.float(scaleY), C.float(centerX), C.float(centerY))
-	return this
+	return this.Combine(&Transform{
+		scaleX, 0, centerX * (1 - scaleX),
+		0, scaleY, centerY * (1 - scaleY),
+		0, 0, 1,
+	})
+}
+
+// Combine the current transform with a shear
+//
+// A point (x, y) is mapped to (x + shearX*y, y + shearY*x).
+//
+// 	shearX: Shear factor along the X axis
+// 	shearY: Shear factor along the Y axis
+func (this *Transform) Shear(shearX, shearY float32) *Transform {
+	return this.Combine(&Transform{
+		1, shearX, 0,
+		shearY, 1, 0,
+		0, 0, 1,
+	})
+}
+
+// Combine the current transform with a skew
+//
+// This is a shear given by angles: the Y axis is tilted by angleX
+// towards the X axis, and the X axis by angleY towards the Y axis.
+//
+// 	angleX: Skew angle along the X axis, in degrees
+// 	angleY: Skew angle along the Y axis, in degrees
+func (this *Transform) Skew(angleX, angleY float32) *Transform {
+	return this.Shear(
+		float32(math.Tan(float64(angleX)*math.Pi/180)),
+		float32(math.Tan(float64(angleY)*math.Pi/180)),
+	)
+}
+
+// Split an affine transform into translation, rotation, scale and shear
+//
+// The result rebuilds the transform with NewTransformFromComponents.
+// A negative determinant (a mirrored transform) gives a negative
+// vertical scale. If the transform flattens the X axis, the zero
+// value is returned.
+func (this *Transform) Decompose() (components TransformComponents) {
+	a, b := this[0], this[3] // image of the X axis
+	c, d := this[1], this[4] // image of the Y axis
+
+	scaleX := float32(math.Hypot(float64(a), float64(b)))
+	if scaleX == 0 {
+		return
+	}
+
+	components.Translation = Vector2f{this[2], this[5]}
+	components.Rotation = float32(math.Atan2(float64(b), float64(a)) * 180 / math.Pi)
+	components.Scale = Vector2f{scaleX, (a*d - b*c) / scaleX}
+	components.Shear = (a*c + b*d) / (scaleX * scaleX)
+	return
+}
+
+// Return the sine and cosine of an angle given in degrees,
+// computed in single precision like SFML does
+func sinCosDegrees(angle float32) (sin, cos float32) {
+	rad := angle * float32(3.141592654) / 180
+	return float32(math.Sin(float64(rad))), float32(math.Cos(float64(rad)))
 }
 
 /////////////////////////////////////
@@ -176,10 +311,6 @@ func (this *Transform) toC() (transform C.sfTransform) {
 	return
 }
 
-func (this *Transform) toCPtr() *C.sfTransform {
-	return (*C.sfTransform)(unsafe.Pointer(this))
-}
-
 /////////////////////////////////////
 ///		TEST
 /////////////////////////////////////
@@ -0,0 +1,138 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "math"
+
+/////////////////////////////////////
+///		CONSTS
+/////////////////////////////////////
+
+// State of a drawable which has been changed on the Go side
+// but not yet sent to its C object
+type dirtyFlags uint8
+
+const (
+	dirtyPosition dirtyFlags = 1 << iota
+	dirtyScale
+	dirtyOrigin
+	dirtyRotation
+	dirtyColor
+	dirtyOutlineColor
+	dirtyTextureRect
+)
+
+/////////////////////////////////////
+///		STRUCTS
+/////////////////////////////////////
+
+// Go side copy of the position, scale, origin and rotation of
+// a drawable (Sprite, Text and the shapes)
+//
+// Getters read this copy and setters only update it, so neither
+// goes through cgo. The drawable pushes the dirty state to its
+// C object right before it gets drawn.
+type transformState struct {
+	position Vector2f
+	scale    Vector2f
+	origin   Vector2f
+	rotation float32
+
+	transform        Transform
+	inverse          Transform
+	transformUpdated bool
+	inverseUpdated   bool
+
+	dirty dirtyFlags
+}
+
+/////////////////////////////////////
+///		FUNCS
+/////////////////////////////////////
+
+// Return the state of a freshly created SFML transformable
+func newTransformState() transformState {
+	return transformState{scale: Vector2f{1, 1}}
+}
+
+func (this *transformState) setPosition(position Vector2f) {
+	this.position = position
+	this.invalidate(dirtyPosition)
+}
+
+func (this *transformState) setScale(scale Vector2f) {
+	this.scale = scale
+	this.invalidate(dirtyScale)
+}
+
+func (this *transformState) setOrigin(origin Vector2f) {
+	this.origin = origin
+	this.invalidate(dirtyOrigin)
+}
+
+// The rotation is kept in the range [0, 360] like SFML does
+func (this *transformState) setRotation(angle float32) {
+	this.rotation = float32(math.Mod(float64(angle), 360))
+	if this.rotation < 0 {
+		this.rotation += 360
+	}
+	this.invalidate(dirtyRotation)
+}
+
+func (this *transformState) move(offset Vector2f) {
+	this.setPosition(this.position.Plus(offset))
+}
+
+func (this *transformState) scaleBy(factor Vector2f) {
+	this.setScale(this.scale.Mul(factor))
+}
+
+func (this *transformState) rotate(angle float32) {
+	this.setRotation(this.rotation + angle)
+}
+
+// Mark some of the state as changed and drop the cached transforms
+func (this *transformState) invalidate(flags dirtyFlags) {
+	this.dirty |= flags
+	this.transformUpdated = false
+	this.inverseUpdated = false
+}
+
+// Compute the combined transform the same way sf::Transformable does
+func (this *transformState) getTransform() Transform {
+	if !this.transformUpdated {
+		this.transform = transformableMatrix(this.position, this.scale, this.origin, this.rotation)
+		this.transformUpdated = true
+	}
+	return this.transform
+}
+
+func (this *transformState) getInverseTransform() Transform {
+	if !this.inverseUpdated {
+		transform := this.getTransform()
+		this.inverse = transform.GetInverse()
+		this.inverseUpdated = true
+	}
+	return this.inverse
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// Combined transform of a position, scale, origin and rotation, as sf::Transformable computes it
+func transformableMatrix(position, scale, origin Vector2f, rotation float32) Transform {
+	sine, cosine := sinCosDegrees(-rotation)
+	sxc := scale.X * cosine
+	syc := scale.Y * cosine
+	sxs := scale.X * sine
+	sys := scale.Y * sine
+	tx := -origin.X*sxc - origin.Y*sys + position.X
+	ty := origin.X*sxs - origin.Y*syc + position.Y
+
+	return Transform{
+		sxc, sys, tx,
+		-sxs, syc, ty,
+		0, 0, 1,
+	}
+}
@@ -0,0 +1,256 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import "testing"
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+// The part of a drawable mirrored in Go, read through its getters
+type mirroredState struct {
+	position, scale, origin Vector2f
+	rotation                float32
+	transform, inverse      Transform
+	globalBounds            FloatRect
+}
+
+// Anything with the mirrored getters of transformState
+type mirroredDrawable interface {
+	Transformer
+	GetGlobalBounds() FloatRect
+}
+
+func readMirroredState(drawable mirroredDrawable) mirroredState {
+	return mirroredState{
+		position:     drawable.GetPosition(),
+		scale:        drawable.GetScale(),
+		origin:       drawable.GetOrigin(),
+		rotation:     drawable.GetRotation(),
+		transform:    drawable.GetTransform(),
+		inverse:      drawable.GetInverseTransform(),
+		globalBounds: drawable.GetGlobalBounds(),
+	}
+}
+
+// Check the mirrored state against what CSFML reports after a flush
+func assertMirrorsCSFML(t *testing.T, name string, mirrored mirroredState, reported csfmlDrawableState) {
+	t.Helper()
+
+	vectorNear := func(a, b Vector2f) bool { return floatNear(a.X, b.X) && floatNear(a.Y, b.Y) }
+
+	if !vectorNear(mirrored.position, reported.Position) {
+		t.Errorf("%s: position %v, CSFML reports %v", name, mirrored.position, reported.Position)
+	}
+	if !vectorNear(mirrored.scale, reported.Scale) {
+		t.Errorf("%s: scale %v, CSFML reports %v", name, mirrored.scale, reported.Scale)
+	}
+	if !vectorNear(mirrored.origin, reported.Origin) {
+		t.Errorf("%s: origin %v, CSFML reports %v", name, mirrored.origin, reported.Origin)
+	}
+	if !floatNear(mirrored.rotation, reported.Rotation) {
+		t.Errorf("%s: rotation %v, CSFML reports %v", name, mirrored.rotation, reported.Rotation)
+	}
+	assertTransformNear(t, name+" transform", mirrored.transform, reported.Transform)
+	assertTransformNear(t, name+" inverse transform", mirrored.inverse, reported.InverseTransform)
+	assertRectNear(t, name+" global bounds", mirrored.globalBounds, reported.GlobalBounds)
+}
+
+// A sequence of changes applied to a drawable, checked after each step
+var mirrorSteps = []struct {
+	name  string
+	apply func(Transformer)
+}{
+	{"SetPosition", func(d Transformer) { d.SetPosition(Vector2f{120, -45}) }},
+	{"SetScale", func(d Transformer) { d.SetScale(Vector2f{2, -0.5}) }},
+	{"SetOrigin", func(d Transformer) { d.SetOrigin(Vector2f{16, 8}) }},
+	{"SetRotation", func(d Transformer) { d.SetRotation(-30) }},
+	{"Move", func(d Transformer) { d.Move(Vector2f{-20, 5}) }},
+	{"Scale", func(d Transformer) { d.Scale(Vector2f{1.5, 3}) }},
+	{"Rotate", func(d Transformer) { d.Rotate(400) }},
+}
+
+// Apply the steps one after the other and compare with CSFML after each flush
+func checkMirrorSteps(t *testing.T, drawable mirroredDrawable, flush func(), reported func() csfmlDrawableState) {
+	t.Helper()
+
+	flush()
+	assertMirrorsCSFML(t, "initial", readMirroredState(drawable), reported())
+
+	for _, step := range mirrorSteps {
+		step.apply(drawable)
+		flush()
+		assertMirrorsCSFML(t, step.name, readMirroredState(drawable), reported())
+	}
+}
+
+/////////////////////////////////////
+///		TESTS
+/////////////////////////////////////
+
+func TestSpriteMirrorsCSFML(t *testing.T) {
+	sprite, err := NewSprite(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sprite.SetTextureRect(IntRect{4, 8, 32, 24})
+	sprite.SetColor(Color{10, 20, 30, 40})
+
+	checkMirrorSteps(t, sprite, sprite.flush, func() csfmlDrawableState { return csfmlSpriteState(sprite) })
+
+	reported := csfmlSpriteState(sprite)
+	if reported.Color != sprite.GetColor() {
+		t.Errorf("color %v, CSFML reports %v", sprite.GetColor(), reported.Color)
+	}
+	if reported.TextureRect != sprite.GetTextureRect() {
+		t.Errorf("texture rect %v, CSFML reports %v", sprite.GetTextureRect(), reported.TextureRect)
+	}
+}
+
+func TestRectangleShapeMirrorsCSFML(t *testing.T) {
+	shape, err := NewRectangleShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape.SetSize(Vector2f{50, 20})
+	shape.SetOutlineThickness(2)
+	shape.SetFillColor(Color{200, 100, 50, 255})
+	shape.SetOutlineColor(Color{1, 2, 3, 4})
+	shape.SetTextureRect(IntRect{0, 0, 16, 16})
+
+	checkMirrorSteps(t, shape, shape.flush, func() csfmlDrawableState { return csfmlRectangleShapeState(shape) })
+
+	reported := csfmlRectangleShapeState(shape)
+	if reported.Color != shape.GetFillColor() || reported.OutlineColor != shape.GetOutlineColor() {
+		t.Errorf("colors %v/%v, CSFML reports %v/%v", shape.GetFillColor(), shape.GetOutlineColor(), reported.Color, reported.OutlineColor)
+	}
+	if reported.TextureRect != shape.GetTextureRect() {
+		t.Errorf("texture rect %v, CSFML reports %v", shape.GetTextureRect(), reported.TextureRect)
+	}
+}
+
+func TestCircleShapeMirrorsCSFML(t *testing.T) {
+	shape, err := NewCircleShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape.SetRadius(25)
+	shape.SetPointCount(12)
+
+	checkMirrorSteps(t, shape, shape.flush, func() csfmlDrawableState { return csfmlCircleShapeState(shape) })
+}
+
+func TestConvexShapeMirrorsCSFML(t *testing.T) {
+	shape, err := NewConvexShape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape.SetPointCount(3)
+	shape.SetPoint(0, Vector2f{0, 0})
+	shape.SetPoint(1, Vector2f{40, 10})
+	shape.SetPoint(2, Vector2f{10, 30})
+
+	checkMirrorSteps(t, shape, shape.flush, func() csfmlDrawableState { return csfmlConvexShapeState(shape) })
+}
+
+func TestTextMirrorsCSFML(t *testing.T) {
+	//the glyphs of a text are uploaded to a texture to compute its bounds
+	if !HeadlessAvailable() {
+		t.Skip("no display available")
+	}
+
+	font, err := NewFontFromFile("samples/sfmlEvents/resources/Vera.ttf")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	text, err := NewText(font)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text.SetString("Mirrored")
+	text.SetColor(Color{9, 8, 7, 6})
+
+	checkMirrorSteps(t, text, text.flush, func() csfmlDrawableState { return csfmlTextState(text) })
+
+	if reported := csfmlTextState(text); reported.Color != text.GetColor() {
+		t.Errorf("color %v, CSFML reports %v", text.GetColor(), reported.Color)
+	}
+}
+
+// Only the changed state may be flushed, the rest must survive
+func TestSpriteFlushKeepsUnchangedState(t *testing.T) {
+	sprite, err := NewSprite(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sprite.SetTextureRect(IntRect{0, 0, 10, 10})
+	sprite.SetPosition(Vector2f{5, 6})
+	sprite.SetRotation(45)
+	sprite.flush()
+
+	sprite.SetScale(Vector2f{3, 3})
+	if sprite.dirty != dirtyScale {
+		t.Errorf("dirty flags %b after SetScale, want %b", sprite.dirty, dirtyScale)
+	}
+	sprite.flush()
+	if sprite.dirty != 0 {
+		t.Errorf("dirty flags %b after flush, want 0", sprite.dirty)
+	}
+
+	assertMirrorsCSFML(t, "after partial flush", readMirroredState(sprite), csfmlSpriteState(sprite))
+}
+
+/////////////////////////////////////
+///		BENCHMARKS
+/////////////////////////////////////
+
+// What a game does to most of its sprites every frame: move them,
+// turn them and read back their bounds for culling or collisions
+
+var benchBoundsSink FloatRect
+
+func BenchmarkSpriteUpdateMirrored(b *testing.B) {
+	sprite, _ := NewSprite(nil)
+	sprite.SetTextureRect(IntRect{0, 0, 32, 32})
+
+	for i := 0; i < b.N; i++ {
+		sprite.SetPosition(Vector2f{float32(i % 800), 300})
+		sprite.SetRotation(float32(i % 360))
+		benchBoundsSink = sprite.GetGlobalBounds()
+		//drawn once per update
+		sprite.flush()
+	}
+}
+
+func BenchmarkSpriteUpdateCSFML(b *testing.B) {
+	sprite, _ := NewSprite(nil)
+	sprite.SetTextureRect(IntRect{0, 0, 32, 32})
+	sprite.flush()
+
+	for i := 0; i < b.N; i++ {
+		benchBoundsSink = csfmlSpriteUpdate(sprite, Vector2f{float32(i % 800), 300}, float32(i%360))
+	}
+}
+
+func BenchmarkSpriteGetTransformMirrored(b *testing.B) {
+	sprite, _ := NewSprite(nil)
+	sprite.SetPosition(Vector2f{10, 20})
+	sprite.SetRotation(30)
+
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = sprite.GetTransform()
+	}
+}
+
+func BenchmarkSpriteGetTransformCSFML(b *testing.B) {
+	sprite, _ := NewSprite(nil)
+	sprite.SetPosition(Vector2f{10, 20})
+	sprite.SetRotation(30)
+	sprite.flush()
+
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = csfmlSpriteGetTransform(sprite)
+	}
+}
@@ -0,0 +1,216 @@
+// Added by Edgaru089
+
+package gosfml2
+
+import (
+	"math"
+	"testing"
+)
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+const transformEpsilon = 1e-4
+
+// A few transforms covering translation, rotation, scaling and shearing
+func testTransforms() []Transform {
+	rotated := TransformIdentity()
+	rotated.Rotate(30)
+
+	scaled := TransformIdentity()
+	scaled.Scale(2, -3)
+
+	combined := TransformIdentity()
+	combined.Translate(100, -50).Rotate(-75).Scale(0.5, 4)
+
+	sheared := TransformIdentity()
+	sheared.Translate(12, 7).Shear(0.25, -0.5)
+
+	return []Transform{
+		TransformIdentity(),
+		NewTransformFromMatrix(1, 0, 42, 0, 1, -17, 0, 0, 1),
+		rotated,
+		scaled,
+		combined,
+		sheared,
+	}
+}
+
+func floatNear(a, b float32) bool {
+	//relative for large values, absolute around zero
+	return math.Abs(float64(a-b)) <= transformEpsilon*math.Max(1, math.Abs(float64(b)))
+}
+
+func assertTransformNear(t *testing.T, name string, got, want Transform) {
+	t.Helper()
+	for i := range got {
+		if !floatNear(got[i], want[i]) {
+			t.Errorf("%s: got %v, CSFML gives %v", name, got, want)
+			return
+		}
+	}
+}
+
+func assertRectNear(t *testing.T, name string, got, want FloatRect) {
+	t.Helper()
+	if !floatNear(got.Left, want.Left) || !floatNear(got.Top, want.Top) ||
+		!floatNear(got.Width, want.Width) || !floatNear(got.Height, want.Height) {
+		t.Errorf("%s: got %v, CSFML gives %v", name, got, want)
+	}
+}
+
+/////////////////////////////////////
+///		TESTS
+/////////////////////////////////////
+
+func TestTransformCombine(t *testing.T) {
+	for _, a := range testTransforms() {
+		for _, b := range testTransforms() {
+			got := a
+			got.Combine(&b)
+			assertTransformNear(t, "Combine", got, csfmlTransformCombine(a, b))
+		}
+	}
+}
+
+func TestTransformTranslateRotateScale(t *testing.T) {
+	for _, transform := range testTransforms() {
+		got := transform
+		got.Translate(-3.5, 8)
+		assertTransformNear(t, "Translate", got, csfmlTransformTranslate(transform, -3.5, 8))
+
+		for _, angle := range []float32{0, 45, 90, -130, 720.5} {
+			got = transform
+			got.Rotate(angle)
+			assertTransformNear(t, "Rotate", got, csfmlTransformRotate(transform, angle))
+		}
+
+		got = transform
+		got.Scale(-2, 0.25)
+		assertTransformNear(t, "Scale", got, csfmlTransformScale(transform, -2, 0.25))
+	}
+}
+
+func TestTransformWithCenter(t *testing.T) {
+	for _, transform := range testTransforms() {
+		for _, angle := range []float32{30, -90, 180} {
+			got := transform
+			got.RotateWithCenter(angle, 64, -32)
+			assertTransformNear(t, "RotateWithCenter", got, csfmlTransformRotateWithCenter(transform, angle, 64, -32))
+		}
+
+		got := transform
+		got.ScaleWithCenter(3, -0.5, 10, 20)
+		assertTransformNear(t, "ScaleWithCenter", got, csfmlTransformScaleWithCenter(transform, 3, -0.5, 10, 20))
+	}
+}
+
+func TestTransformGetInverse(t *testing.T) {
+	for _, transform := range testTransforms() {
+		assertTransformNear(t, "GetInverse", transform.GetInverse(), csfmlTransformGetInverse(transform))
+	}
+}
+
+func TestTransformGetInverseSingular(t *testing.T) {
+	singular := TransformIdentity()
+	singular.Translate(5, 5).Scale(0, 2)
+
+	got := singular.GetInverse()
+	assertTransformNear(t, "GetInverse (singular)", got, csfmlTransformGetInverse(singular))
+	if got != TransformIdentity() {
+		t.Errorf("GetInverse (singular): got %v, want the identity", got)
+	}
+}
+
+func TestTransformPointAndRect(t *testing.T) {
+	rects := []FloatRect{
+		{0, 0, 1, 1},
+		{-20, 35, 100, 50},
+		{10, 10, 0, 0},
+		{5, -5, -10, 20},
+	}
+
+	for _, transform := range testTransforms() {
+		for _, rect := range rects {
+			point := Vector2f{rect.Left, rect.Top}
+			got, want := transform.TransformPoint(point), csfmlTransformPoint(transform, point)
+			if !floatNear(got.X, want.X) || !floatNear(got.Y, want.Y) {
+				t.Errorf("TransformPoint: got %v, CSFML gives %v", got, want)
+			}
+
+			assertRectNear(t, "TransformRect", transform.TransformRect(rect), csfmlTransformRect(transform, rect))
+		}
+	}
+}
+
+/////////////////////////////////////
+///		BENCHMARKS
+/////////////////////////////////////
+
+var (
+	benchTransformSink Transform
+	benchRectSink      FloatRect
+)
+
+func BenchmarkTransformCombineGo(b *testing.B) {
+	transforms := testTransforms()
+	for i := 0; i < b.N; i++ {
+		result := transforms[4]
+		result.Combine(&transforms[5])
+		benchTransformSink = result
+	}
+}
+
+func BenchmarkTransformCombineCSFML(b *testing.B) {
+	transforms := testTransforms()
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = csfmlTransformCombine(transforms[4], transforms[5])
+	}
+}
+
+func BenchmarkTransformRotateGo(b *testing.B) {
+	transform := testTransforms()[4]
+	for i := 0; i < b.N; i++ {
+		result := transform
+		result.RotateWithCenter(33, 10, 20)
+		benchTransformSink = result
+	}
+}
+
+func BenchmarkTransformRotateCSFML(b *testing.B) {
+	transform := testTransforms()[4]
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = csfmlTransformRotateWithCenter(transform, 33, 10, 20)
+	}
+}
+
+func BenchmarkTransformGetInverseGo(b *testing.B) {
+	transform := testTransforms()[4]
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = transform.GetInverse()
+	}
+}
+
+func BenchmarkTransformGetInverseCSFML(b *testing.B) {
+	transform := testTransforms()[4]
+	for i := 0; i < b.N; i++ {
+		benchTransformSink = csfmlTransformGetInverse(transform)
+	}
+}
+
+func BenchmarkTransformRectGo(b *testing.B) {
+	transform := testTransforms()[4]
+	rect := FloatRect{-20, 35, 100, 50}
+	for i := 0; i < b.N; i++ {
+		benchRectSink = transform.TransformRect(rect)
+	}
+}
+
+func BenchmarkTransformRectCSFML(b *testing.B) {
+	transform := testTransforms()[4]
+	rect := FloatRect{-20, 35, 100, 50}
+	for i := 0; i < b.N; i++ {
+		benchRectSink = csfmlTransformRect(transform, rect)
+	}
+}
@@ -7,6 +7,8 @@ package gosfml2
 // #include <SFML/System.h>
 import "C"
 
+import "math"
+
 /////////////////////////////////////
 ///		STRUCTS
 /////////////////////////////////////
@@ -44,6 +46,86 @@ func (this Vector2i) Minus(other Vector2i) Vector2i {
 	return Vector2i{X: this.X - other.X, Y: this.Y - other.Y}
 }
 
+// Returns the vector with both components negated.
+func (this Vector2i) Neg() Vector2i {
+	return Vector2i{X: -this.X, Y: -this.Y}
+}
+
+// Returns the vector multiplied by a scalar.
+func (this Vector2i) Scale(factor int) Vector2i {
+	return Vector2i{X: this.X * factor, Y: this.Y * factor}
+}
+
+// Returns the component-wise product of two vectors.
+func (this Vector2i) Mul(other Vector2i) Vector2i {
+	return Vector2i{X: this.X * other.X, Y: this.Y * other.Y}
+}
+
+// Returns the component-wise quotient of two vectors.
+func (this Vector2i) Div(other Vector2i) Vector2i {
+	return Vector2i{X: this.X / other.X, Y: this.Y / other.Y}
+}
+
+// Returns the dot product of two vectors.
+func (this Vector2i) Dot(other Vector2i) int {
+	return this.X*other.X + this.Y*other.Y
+}
+
+// Returns the Z component of the cross product of two vectors.
+func (this Vector2i) Cross(other Vector2i) int {
+	return this.X*other.Y - this.Y*other.X
+}
+
+// Returns the squared length of the vector.
+func (this Vector2i) LengthSquared() int {
+	return this.X*this.X + this.Y*this.Y
+}
+
+// Returns the length of the vector.
+func (this Vector2i) Length() float32 {
+	return float32(math.Sqrt(float64(this.LengthSquared())))
+}
+
+// Returns the distance between two points.
+func (this Vector2i) Distance(other Vector2i) float32 {
+	return this.Minus(other).Length()
+}
+
+// Returns the vector rotated by +90 degrees.
+func (this Vector2i) Perpendicular() Vector2i {
+	return Vector2i{X: -this.Y, Y: this.X}
+}
+
+// Returns the vector with the absolute value of each component.
+func (this Vector2i) Abs() Vector2i {
+	return Vector2i{X: absInt(this.X), Y: absInt(this.Y)}
+}
+
+// Returns the component-wise minimum of two vectors.
+func (this Vector2i) Min(other Vector2i) Vector2i {
+	return Vector2i{X: minInt(this.X, other.X), Y: minInt(this.Y, other.Y)}
+}
+
+// Returns the component-wise maximum of two vectors.
+func (this Vector2i) Max(other Vector2i) Vector2i {
+	return Vector2i{X: maxInt(this.X, other.X), Y: maxInt(this.Y, other.Y)}
+}
+
+// Returns the vector with each component clamped to [min, max].
+func (this Vector2i) Clamp(min, max Vector2i) Vector2i {
+	return this.Max(min).Min(max)
+}
+
+// Converts the vector to a Vector2f.
+func (this Vector2i) ToVector2f() Vector2f {
+	return Vector2f{X: float32(this.X), Y: float32(this.Y)}
+}
+
+// Converts the vector to a Vector2u, negative components become 0.
+func (this Vector2i) ToVector2u() Vector2u {
+	return Vector2u{X: uint(maxInt(this.X, 0)), Y: uint(maxInt(this.Y, 0))}
+}
+
 /////////////////////////////////////
 // Vector2u
 
@@ -57,9 +139,72 @@ func (this Vector2u) Minus(other Vector2u) Vector2u {
 	return Vector2u{X: this.X - other.X, Y: this.Y - other.Y}
 }
 
+// Returns the vector multiplied by a scalar.
+func (this Vector2u) Scale(factor uint) Vector2u {
+	return Vector2u{X: this.X * factor, Y: this.Y * factor}
+}
+
+// Returns the component-wise product of two vectors.
+func (this Vector2u) Mul(other Vector2u) Vector2u {
+	return Vector2u{X: this.X * other.X, Y: this.Y * other.Y}
+}
+
+// Returns the component-wise quotient of two vectors.
+func (this Vector2u) Div(other Vector2u) Vector2u {
+	return Vector2u{X: this.X / other.X, Y: this.Y / other.Y}
+}
+
+// Returns the dot product of two vectors.
+func (this Vector2u) Dot(other Vector2u) uint {
+	return this.X*other.X + this.Y*other.Y
+}
+
+// Returns the squared length of the vector.
+func (this Vector2u) LengthSquared() uint {
+	return this.X*this.X + this.Y*this.Y
+}
+
+// Returns the length of the vector.
+func (this Vector2u) Length() float32 {
+	return float32(math.Sqrt(float64(this.LengthSquared())))
+}
+
+// Returns the component-wise minimum of two vectors.
+func (this Vector2u) Min(other Vector2u) Vector2u {
+	return Vector2u{X: minUint(this.X, other.X), Y: minUint(this.Y, other.Y)}
+}
+
+// Returns the component-wise maximum of two vectors.
+func (this Vector2u) Max(other Vector2u) Vector2u {
+	return Vector2u{X: maxUint(this.X, other.X), Y: maxUint(this.Y, other.Y)}
+}
+
+// Returns the vector with each component clamped to [min, max].
+func (this Vector2u) Clamp(min, max Vector2u) Vector2u {
+	return this.Max(min).Min(max)
+}
+
+// Converts the vector to a Vector2f.
+func (this Vector2u) ToVector2f() Vector2f {
+	return Vector2f{X: float32(this.X), Y: float32(this.Y)}
+}
+
+// Converts the vector to a Vector2i.
+func (this Vector2u) ToVector2i() Vector2i {
+	return Vector2i{X: int(this.X), Y: int(this.Y)}
+}
+
 /////////////////////////////////////
 // Vector2f
 
+// Returns the unit vector pointing in the given direction.
+//
+// 	angle: Direction, in degrees (0 points along +X, 90 along +Y)
+func Vector2fFromAngle(angle float32) Vector2f {
+	sin, cos := math.Sincos(float64(angle) * math.Pi / 180)
+	return Vector2f{X: float32(cos), Y: float32(sin)}
+}
+
 // Returns the sum of two vectors.
 func (this Vector2f) Plus(other Vector2f) Vector2f {
 	return Vector2f{X: this.X + other.X, Y: this.Y + other.Y}
@@ -70,6 +215,302 @@ func (this Vector2f) Minus(other Vector2f) Vector2f {
 	return Vector2f{X: this.X - other.X, Y: this.Y - other.Y}
 }
 
+// Returns the vector with both components negated.
+func (this Vector2f) Neg() Vector2f {
+	return Vector2f{X: -this.X, Y: -this.Y}
+}
+
+// Returns the vector multiplied by a scalar.
+func (this Vector2f) Scale(factor float32) Vector2f {
+	return Vector2f{X: this.X * factor, Y: this.Y * factor}
+}
+
+// Returns the component-wise product of two vectors.
+func (this Vector2f) Mul(other Vector2f) Vector2f {
+	return Vector2f{X: this.X * other.X, Y: this.Y * other.Y}
+}
+
+// Returns the component-wise quotient of two vectors.
+func (this Vector2f) Div(other Vector2f) Vector2f {
+	return Vector2f{X: this.X / other.X, Y: this.Y / other.Y}
+}
+
+// Returns the dot product of two vectors.
+func (this Vector2f) Dot(other Vector2f) float32 {
+	return this.X*other.X + this.Y*other.Y
+}
+
+// Returns the Z component of the cross product of two vectors.
+func (this Vector2f) Cross(other Vector2f) float32 {
+	return this.X*other.Y - this.Y*other.X
+}
+
+// Returns the squared length of the vector.
+func (this Vector2f) LengthSquared() float32 {
+	return this.X*this.X + this.Y*this.Y
+}
+
+// Returns the length of the vector.
+func (this Vector2f) Length() float32 {
+	return float32(math.Sqrt(float64(this.LengthSquared())))
+}
+
+// Returns the vector scaled to a length of 1.
+//
+// The zero vector is returned unchanged.
+func (this Vector2f) Normalize() Vector2f {
+	length := this.Length()
+	if length == 0 {
+		return this
+	}
+	return Vector2f{X: this.X / length, Y: this.Y / length}
+}
+
+// Returns the distance between two points.
+func (this Vector2f) Distance(other Vector2f) float32 {
+	return this.Minus(other).Length()
+}
+
+// Returns the squared distance between two points.
+func (this Vector2f) DistanceSquared(other Vector2f) float32 {
+	return this.Minus(other).LengthSquared()
+}
+
+// Returns the direction of the vector, in degrees in range (-180, 180].
+func (this Vector2f) Angle() float32 {
+	return float32(math.Atan2(float64(this.Y), float64(this.X)) * 180 / math.Pi)
+}
+
+// Returns the signed angle from this vector to other, in degrees in range (-180, 180].
+func (this Vector2f) AngleTo(other Vector2f) float32 {
+	return float32(math.Atan2(float64(this.Cross(other)), float64(this.Dot(other))) * 180 / math.Pi)
+}
+
+// Returns the vector rotated around the origin.
+//
+// 	angle: Rotation angle, in degrees
+func (this Vector2f) Rotate(angle float32) Vector2f {
+	sin, cos := math.Sincos(float64(angle) * math.Pi / 180)
+	x, y := float64(this.X), float64(this.Y)
+	return Vector2f{X: float32(x*cos - y*sin), Y: float32(x*sin + y*cos)}
+}
+
+// Returns the linear interpolation between two vectors.
+//
+// 	t: Interpolation factor, 0 gives this and 1 gives other
+func (this Vector2f) Lerp(other Vector2f, t float32) Vector2f {
+	return Vector2f{X: this.X + (other.X-this.X)*t, Y: this.Y + (other.Y-this.Y)*t}
+}
+
+// Returns the vector rotated by +90 degrees.
+func (this Vector2f) Perpendicular() Vector2f {
+	return Vector2f{X: -this.Y, Y: this.X}
+}
+
+// Returns the projection of the vector onto axis.
+//
+// The zero vector is returned if axis is the zero vector.
+func (this Vector2f) Project(axis Vector2f) Vector2f {
+	lengthSquared := axis.LengthSquared()
+	if lengthSquared == 0 {
+		return Vector2f{}
+	}
+	return axis.Scale(this.Dot(axis) / lengthSquared)
+}
+
+// Returns the vector with the absolute value of each component.
+func (this Vector2f) Abs() Vector2f {
+	return Vector2f{X: abs32(this.X), Y: abs32(this.Y)}
+}
+
+// Returns the component-wise minimum of two vectors.
+func (this Vector2f) Min(other Vector2f) Vector2f {
+	return Vector2f{X: min32(this.X, other.X), Y: min32(this.Y, other.Y)}
+}
+
+// Returns the component-wise maximum of two vectors.
+func (this Vector2f) Max(other Vector2f) Vector2f {
+	return Vector2f{X: max32(this.X, other.X), Y: max32(this.Y, other.Y)}
+}
+
+// Returns the vector with each component clamped to [min, max].
+func (this Vector2f) Clamp(min, max Vector2f) Vector2f {
+	return this.Max(min).Min(max)
+}
+
+// Converts the vector to a Vector2i, truncating towards zero.
+func (this Vector2f) ToVector2i() Vector2i {
+	return Vector2i{X: int(this.X), Y: int(this.Y)}
+}
+
+// Converts the vector to a Vector2u, truncating towards zero; negative components become 0.
+func (this Vector2f) ToVector2u() Vector2u {
+	return Vector2u{X: uint(max32(this.X, 0)), Y: uint(max32(this.Y, 0))}
+}
+
+/////////////////////////////////////
+// Vector3f
+
+// Returns the sum of two vectors.
+func (this Vector3f) Plus(other Vector3f) Vector3f {
+	return Vector3f{X: this.X + other.X, Y: this.Y + other.Y, Z: this.Z + other.Z}
+}
+
+// Returns the difference of two vectors.
+func (this Vector3f) Minus(other Vector3f) Vector3f {
+	return Vector3f{X: this.X - other.X, Y: this.Y - other.Y, Z: this.Z - other.Z}
+}
+
+// Returns the vector with all components negated.
+func (this Vector3f) Neg() Vector3f {
+	return Vector3f{X: -this.X, Y: -this.Y, Z: -this.Z}
+}
+
+// Returns the vector multiplied by a scalar.
+func (this Vector3f) Scale(factor float32) Vector3f {
+	return Vector3f{X: this.X * factor, Y: this.Y * factor, Z: this.Z * factor}
+}
+
+// Returns the component-wise product of two vectors.
+func (this Vector3f) Mul(other Vector3f) Vector3f {
+	return Vector3f{X: this.X * other.X, Y: this.Y * other.Y, Z: this.Z * other.Z}
+}
+
+// Returns the component-wise quotient of two vectors.
+func (this Vector3f) Div(other Vector3f) Vector3f {
+	return Vector3f{X: this.X / other.X, Y: this.Y / other.Y, Z: this.Z / other.Z}
+}
+
+// Returns the dot product of two vectors.
+func (this Vector3f) Dot(other Vector3f) float32 {
+	return this.X*other.X + this.Y*other.Y + this.Z*other.Z
+}
+
+// Returns the cross product of two vectors.
+func (this Vector3f) Cross(other Vector3f) Vector3f {
+	return Vector3f{
+		X: this.Y*other.Z - this.Z*other.Y,
+		Y: this.Z*other.X - this.X*other.Z,
+		Z: this.X*other.Y - this.Y*other.X,
+	}
+}
+
+// Returns the squared length of the vector.
+func (this Vector3f) LengthSquared() float32 {
+	return this.X*this.X + this.Y*this.Y + this.Z*this.Z
+}
+
+// Returns the length of the vector.
+func (this Vector3f) Length() float32 {
+	return float32(math.Sqrt(float64(this.LengthSquared())))
+}
+
+// Returns the vector scaled to a length of 1.
+//
+// The zero vector is returned unchanged.
+func (this Vector3f) Normalize() Vector3f {
+	length := this.Length()
+	if length == 0 {
+		return this
+	}
+	return Vector3f{X: this.X / length, Y: this.Y / length, Z: this.Z / length}
+}
+
+// Returns the distance between two points.
+func (this Vector3f) Distance(other Vector3f) float32 {
+	return this.Minus(other).Length()
+}
+
+// Returns the linear interpolation between two vectors.
+//
+// 	t: Interpolation factor, 0 gives this and 1 gives other
+func (this Vector3f) Lerp(other Vector3f, t float32) Vector3f {
+	return Vector3f{X: this.X + (other.X-this.X)*t, Y: this.Y + (other.Y-this.Y)*t, Z: this.Z + (other.Z-this.Z)*t}
+}
+
+// Returns the projection of the vector onto axis.
+//
+// The zero vector is returned if axis is the zero vector.
+func (this Vector3f) Project(axis Vector3f) Vector3f {
+	lengthSquared := axis.LengthSquared()
+	if lengthSquared == 0 {
+		return Vector3f{}
+	}
+	return axis.Scale(this.Dot(axis) / lengthSquared)
+}
+
+// Returns the component-wise minimum of two vectors.
+func (this Vector3f) Min(other Vector3f) Vector3f {
+	return Vector3f{X: min32(this.X, other.X), Y: min32(this.Y, other.Y), Z: min32(this.Z, other.Z)}
+}
+
+// Returns the component-wise maximum of two vectors.
+func (this Vector3f) Max(other Vector3f) Vector3f {
+	return Vector3f{X: max32(this.X, other.X), Y: max32(this.Y, other.Y), Z: max32(this.Z, other.Z)}
+}
+
+// Returns the vector with each component clamped to [min, max].
+func (this Vector3f) Clamp(min, max Vector3f) Vector3f {
+	return this.Max(min).Min(max)
+}
+
+/////////////////////////////////////
+///		HELPERS
+/////////////////////////////////////
+
+func abs32(a float32) float32 {
+	return float32(math.Abs(float64(a)))
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func minUint(a, b uint) uint {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxUint(a, b uint) uint {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 /////////////////////////////////////
 ///		GO <-> C
 /////////////////////////////////////
@@ -149,6 +149,35 @@ func (this *View) Zoom(factor float32) {
 	C.sfView_zoom(this.cptr, C.float(factor))
 }
 
+// Get the projection transform of a view
+//
+// The transform maps world coordinates to normalized device
+// coordinates, from (-1, -1) at the bottom-left to (1, 1) at
+// the top-right of the viewport. It is computed in Go from the
+// center, size and rotation of the view.
+func (this *View) GetTransform() Transform {
+	center, size := this.GetCenter(), this.GetSize()
+	sin, cos := sinCosDegrees(this.GetRotation())
+
+	tx := -center.X*cos - center.Y*sin + center.X
+	ty := center.X*sin - center.Y*cos + center.Y
+
+	a, b := 2/size.X, -2/size.Y
+	c, d := -a*center.X, -b*center.Y
+
+	return Transform{
+		a * cos, a * sin, a*tx + c,
+		-b * sin, b * cos, b*ty + d,
+		0, 0, 1,
+	}
+}
+
+// Get the inverse projection transform of a view
+func (this *View) GetInverseTransform() Transform {
+	transform := this.GetTransform()
+	return transform.GetInverse()
+}
+
 /////////////////////////////////////
 ///		GO <-> C
 /////////////////////////////////////
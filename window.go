@@ -9,8 +9,11 @@ package gosfml2
 import "C"
 
 import (
+	"context"
 	"errors"
 	"runtime"
+	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -34,7 +37,8 @@ type WindowStyle int
 /////////////////////////////////////
 
 type Window struct {
-	cptr *C.sfWindow
+	cptr  *C.sfWindow
+	hooks eventHooks
 }
 
 /////////////////////////////////////
@@ -71,20 +75,39 @@ var _ SystemWindow = (*Window)(nil)
 // 	title:           Title of the window
 // 	style:           Window style
 // 	contextSettings: Creation settings (pass nil to use default values)
-func NewWindow(videoMode VideoMode, title string, style WindowStyle, contextSettings ContextSettings) (window *Window) {
+//
+// Returns ErrNoDisplay if HeadlessAvailable reports no display.
+func NewWindow(videoMode VideoMode, title string, style WindowStyle, contextSettings ContextSettings) (*Window, error) {
+	if err := requireDisplay(); err != nil {
+		return nil, err
+	}
+
 	//string conversion
 	utf32 := strToRunes(title)
 
 	//convert contextSettings to C
 	cs := contextSettings.toC()
 
-	//create the window
-	window = &Window{C.sfWindow_createUnicode(videoMode.toC(), (*C.sfUint32)(unsafe.Pointer(&utf32[0])), C.sfUint32(style), &cs)}
+	//create the window on the main thread
+	var cptr *C.sfWindow
+	var message string
+
+	Call(func() {
+		beginErrorCapture()
+		cptr = C.sfWindow_createUnicode(videoMode.toC(), (*C.sfUint32)(unsafe.Pointer(&utf32[0])), C.sfUint32(style), &cs)
+		message = endErrorCapture()
+	})
+
+	if cptr == nil {
+		return nil, newLoadError("window", "", message)
+	}
+
+	window := &Window{cptr: cptr}
 
 	//GC cleanup
 	runtime.SetFinalizer(window, (*Window).destroy)
 
-	return window
+	return window, nil
 }
 
 // Get the creation settings of a window
@@ -97,12 +120,19 @@ func (this *Window) GetSettings() (settings ContextSettings) {
 //
 // 	size: New size, in pixels
 func (this *Window) SetSize(size Vector2u) {
-	C.sfWindow_setSize(this.cptr, size.toC())
+	Call(func() {
+		C.sfWindow_setSize(this.cptr, size.toC())
+	})
 }
 
 // Get the size of the rendering region of a window
 func (this *Window) GetSize() Vector2u {
-	size := C.sfWindow_getSize(this.cptr)
+	var size C.sfVector2u
+
+	Call(func() {
+		size = C.sfWindow_getSize(this.cptr)
+	})
+
 	return Vector2u{uint(size.x), uint(size.y)}
 }
 
@@ -112,12 +142,16 @@ func (this *Window) GetSize() Vector2u {
 //
 // 	pos: New position, in pixels
 func (this *Window) SetPosition(pos Vector2i) {
-	C.sfWindow_setPosition(this.cptr, pos.toC())
+	Call(func() {
+		C.sfWindow_setPosition(this.cptr, pos.toC())
+	})
 }
 
 // Get the position of a render window
 func (this *Window) GetPosition() (pos Vector2i) {
-	pos.fromC(C.sfWindow_getPosition(this.cptr))
+	Call(func() {
+		pos.fromC(C.sfWindow_getPosition(this.cptr))
+	})
 	return
 }
 
@@ -128,25 +162,99 @@ func (this *Window) IsOpen() bool {
 
 // Close a window (but doesn't destroy the internal data)
 func (this *Window) Close() {
-	C.sfWindow_close(this.cptr)
+	Call(func() {
+		C.sfWindow_close(this.cptr)
+	})
 }
 
 // Destroy an existing window
 func (this *Window) destroy() {
-	globalMutex.Lock()
-	C.sfWindow_destroy(this.cptr)
-	globalMutex.Unlock()
+	callAsync(func() {
+		globalMutex.Lock()
+		C.sfWindow_destroy(this.cptr)
+		globalMutex.Unlock()
+	})
 }
 
 // Get the event on top of event queue of a window, if any, and pop it
 //
+// Events consumed by the attached EventDispatcher are skipped.
+//
 // returns nil if there are no events left.
 func (this *Window) PollEvent() Event {
+	return this.hooks.poll(this.pollEvent)
+}
+
+// Wait for an event and return it
+//
+// Events consumed by the attached EventDispatcher are skipped.
+func (this *Window) WaitEvent() Event {
+	return this.hooks.wait(this.waitEvent, this.pollEvent)
+}
+
+// Push an event to the event queue of a window
+//
+// Pushed events are returned by PollEvent and WaitEvent in the
+// order they were pushed, ahead of the events of the operating
+// system. They are recorded and dispatched like any other event.
+// A WaitEvent call already blocked is not woken up by PushEvent.
+//
+// PushEvent is safe to call from any goroutine.
+//
+// 	ev: Event to push, nil and pointers to events are ignored
+func (this *Window) PushEvent(ev Event) {
+	this.hooks.push(ev)
+}
+
+// Attach an event dispatcher to a window
+//
+// Every event is passed to the dispatcher before PollEvent
+// and WaitEvent return it, see EventDispatcher.
+//
+// 	dispatcher: Dispatcher to attach, nil to detach the current one
+func (this *Window) SetEventDispatcher(dispatcher *EventDispatcher) {
+	this.hooks.setDispatcher(dispatcher)
+}
+
+// Get the event dispatcher attached to a window, if any
+func (this *Window) GetEventDispatcher() *EventDispatcher {
+	dispatcher, _, _ := this.hooks.attached()
+	return dispatcher
+}
+
+// Record the events of a window
+//
+// Every event returned by PollEvent and WaitEvent (before the
+// dispatcher sees it) is passed to the recorder, and each call
+// to Display starts a new frame.
+//
+// 	recorder: Recorder to attach, nil to stop recording
+func (this *Window) SetEventRecorder(recorder *EventRecorder) {
+	this.hooks.setRecorder(recorder)
+}
+
+// Replay recorded events on a window
+//
+// While a replayer is attached, PollEvent and WaitEvent return the
+// recorded events of the current frame instead of the events of the
+// operating system, which are discarded. Each call to Display
+// advances the replay by one frame.
+//
+// 	replayer: Replayer to attach, nil to get back to live events
+func (this *Window) SetEventReplayer(replayer *EventReplayer) {
+	this.hooks.setReplayer(replayer)
+}
+
+// Pop an event from the event queue of the window
+func (this *Window) pollEvent() Event {
 	cEvent := C.sfEvent{}
+	var hasEvent C.sfBool
 
-	globalMutex.Lock()
-	hasEvent := C.sfWindow_pollEvent(this.cptr, &cEvent)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		hasEvent = C.sfWindow_pollEvent(this.cptr, &cEvent)
+		globalMutex.Unlock()
+	})
 
 	if hasEvent != 0 {
 		return handleEvent(&cEvent)
@@ -154,13 +262,16 @@ func (this *Window) PollEvent() Event {
 	return nil
 }
 
-// Wait for an event and return it
-func (this *Window) WaitEvent() Event {
+// Wait for an event on the event queue of the window
+func (this *Window) waitEvent() Event {
 	cEvent := C.sfEvent{}
+	var hasError C.sfBool
 
-	globalMutex.Lock()
-	hasError := C.sfWindow_waitEvent(this.cptr, &cEvent)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		hasError = C.sfWindow_waitEvent(this.cptr, &cEvent)
+		globalMutex.Unlock()
+	})
 
 	if hasError != 0 {
 		return handleEvent(&cEvent)
@@ -168,13 +279,34 @@ func (this *Window) WaitEvent() Event {
 	return nil
 }
 
+// Wait for an event for at most timeout and return it
+//
+// returns nil if no event arrived in time.
+func (this *Window) WaitEventTimeout(timeout time.Duration) Event {
+	return waitEventTimeout(this.PollEvent, timeout)
+}
+
+// Deliver the events of a window on a channel
+//
+// The events are polled through Call. While Run is active this
+// happens on the main thread; without Run the window is polled from
+// another goroutine, which some systems (e.g. macOS) do not allow.
+// The window is polled after every Display, after every PushEvent,
+// and at least 60 times per second.
+// The channel is closed when ctx is done or the window is closed.
+func (this *Window) Events(ctx context.Context) <-chan Event {
+	return pumpEvents(ctx, &this.hooks, this.PollEvent, this.IsOpen)
+}
+
 // Change the title of a window
 //
 // 	title: New title
 func (this *Window) SetTitle(title string) {
 	utf32 := strToRunes(title)
 
-	C.sfWindow_setUnicodeTitle(this.cptr, (*C.sfUint32)(unsafe.Pointer(&utf32[0])))
+	Call(func() {
+		C.sfWindow_setUnicodeTitle(this.cptr, (*C.sfUint32)(unsafe.Pointer(&utf32[0])))
+	})
 }
 
 // Change a window's icon
@@ -184,7 +316,9 @@ func (this *Window) SetTitle(title string) {
 // 	pixels: Slice of pixels, format must be RGBA 32 bits
 func (this *Window) SetIcon(width, height uint, data []byte) error {
 	if len(data) >= int(width*height*4) {
-		C.sfWindow_setIcon(this.cptr, C.uint(width), C.uint(height), (*C.sfUint8)(&data[0]))
+		Call(func() {
+			C.sfWindow_setIcon(this.cptr, C.uint(width), C.uint(height), (*C.sfUint8)(&data[0]))
+		})
 		return nil
 	}
 	return errors.New("SetIcon: Slice length does not match specified dimensions")
@@ -194,14 +328,18 @@ func (this *Window) SetIcon(width, height uint, data []byte) error {
 //
 // 	limit: Framerate limit, in frames per seconds (use 0 to disable limit)
 func (this *Window) SetFramerateLimit(limit uint) {
-	C.sfWindow_setFramerateLimit(this.cptr, C.uint(limit))
+	Call(func() {
+		C.sfWindow_setFramerateLimit(this.cptr, C.uint(limit))
+	})
 }
 
 ///Change the joystick threshold, ie. the value below which no move event will be generated
 //
 // threshold: New threshold, in range [0, 100]
 func (this *Window) SetJoystickThreshold(threshold float32) {
-	C.sfWindow_setJoystickThreshold(this.cptr, C.float(threshold))
+	Call(func() {
+		C.sfWindow_setJoystickThreshold(this.cptr, C.float(threshold))
+	})
 }
 
 // Enable or disable automatic key-repeat
@@ -212,23 +350,31 @@ func (this *Window) SetJoystickThreshold(threshold float32) {
 //
 // Key repeat is enabled by default.
 func (this *Window) SetKeyRepeatEnabled(enabled bool) {
-	C.sfWindow_setKeyRepeatEnabled(this.cptr, goBool2C(enabled))
+	Call(func() {
+		C.sfWindow_setKeyRepeatEnabled(this.cptr, goBool2C(enabled))
+	})
 }
 
 // Display a window on screen
 func (this *Window) Display() {
-	globalMutex.Lock()
-	C.sfWindow_display(this.cptr)
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		C.sfWindow_display(this.cptr)
+		globalMutex.Unlock()
+	})
+
+	this.hooks.endFrame()
 }
 
 // Enable / disable vertical synchronization on a window
 //
 // 	enabled: true to enable v-sync, false to deactivate
 func (this *Window) SetVSyncEnabled(enabled bool) {
-	globalMutex.Lock()
-	C.sfWindow_setVerticalSyncEnabled(this.cptr, goBool2C(enabled))
-	globalMutex.Unlock()
+	Call(func() {
+		globalMutex.Lock()
+		C.sfWindow_setVerticalSyncEnabled(this.cptr, goBool2C(enabled))
+		globalMutex.Unlock()
+	})
 }
 
 // Activate or deactivate a window as the current target for rendering
@@ -237,9 +383,14 @@ func (this *Window) SetVSyncEnabled(enabled bool) {
 //
 // return True if operation was successful, false otherwise
 func (this *Window) SetActive(active bool) bool {
-	globalMutex.Lock()
-	success := sfBool2Go(C.sfWindow_setActive(this.cptr, goBool2C(active)))
-	globalMutex.Unlock()
+	var success bool
+
+	Call(func() {
+		globalMutex.Lock()
+		success = sfBool2Go(C.sfWindow_setActive(this.cptr, goBool2C(active)))
+		globalMutex.Unlock()
+	})
+
 	return success
 }
 
@@ -247,20 +398,35 @@ func (this *Window) SetActive(active bool) bool {
 //
 // 	visible: true to show, false to hide
 func (this *Window) SetMouseCursorVisible(visible bool) {
-	C.sfWindow_setMouseCursorVisible(this.cptr, goBool2C(visible))
+	Call(func() {
+		C.sfWindow_setMouseCursorVisible(this.cptr, goBool2C(visible))
+	})
 }
 
-var cursorDefault = NewCursorFromSystem(CursorArrow)
+var (
+	//created on first use, as system cursors need a display
+	cursorDefault     *Cursor
+	cursorDefaultOnce sync.Once
+)
+
+func getCursorDefault() *Cursor {
+	cursorDefaultOnce.Do(func() {
+		cursorDefault = NewCursorFromSystem(CursorArrow)
+	})
+	return cursorDefault
+}
 
 // SetMouseCursor sets the displayed mouse cursor of a window
 //
 // To keep things simple, when cursor is nil, the default arrow cursor is set.
 func (win *Window) SetMouseCursor(cursor *Cursor) {
-	if cursor == nil {
-		C.sfWindow_setMouseCursor(win.cptr, cursorDefault.cptr)
-	} else {
-		C.sfWindow_setMouseCursor(win.cptr, cursor.cptr)
-	}
+	Call(func() {
+		if cursor == nil {
+			C.sfWindow_setMouseCursor(win.cptr, getCursorDefault().cptr)
+		} else {
+			C.sfWindow_setMouseCursor(win.cptr, cursor.cptr)
+		}
+	})
 }
 
 // Check whether the window has the input focus
@@ -270,8 +436,11 @@ func (win *Window) SetMouseCursor(cursor *Cursor) {
 // events.
 //
 // 	True if window has focus, false otherwise
-func (this *Window) HasFocus() bool {
-	return sfBool2Go(C.sfWindow_hasFocus(this.cptr))
+func (this *Window) HasFocus() (focus bool) {
+	Call(func() {
+		focus = sfBool2Go(C.sfWindow_hasFocus(this.cptr))
+	})
+	return
 }
 
 // Request the current window to be made the active
@@ -284,5 +453,7 @@ func (this *Window) HasFocus() bool {
 // is free to deny the request.
 // This is not to be confused with Window.SetActive().
 func (this *Window) RequestFocus() {
-	C.sfWindow_requestFocus(this.cptr)
+	Call(func() {
+		C.sfWindow_requestFocus(this.cptr)
+	})
 }